@@ -0,0 +1,64 @@
+/* access.go : allowlists and denylists of users and chats
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+// A list of user or chat identifiers.
+// It is an interface so that the list can live in a database instead of in memory
+type IDList interface {
+	Contains(id int64) bool
+}
+
+// The simplest IDList, a set in memory
+type IDSet map[int64]bool
+
+// Contains reports whether id is in the set
+func (s IDSet) Contains(id int64) bool {
+	return s[id]
+}
+
+// NewIDSet returns an IDSet with the given identifiers
+func NewIDSet(ids ...int64) IDSet {
+	s := make(IDSet, len(ids))
+	for _, id := range ids {
+		s[id] = true
+	}
+	return s
+}
+
+// AccessPolicy decides which updates a bot should handle, based on the user and the chat
+// they come from (see Update.EffectiveUser and Update.EffectiveChat). Nil lists are ignored.
+// The deny lists win over the allow lists. If at least one allow list is set, the update
+// must come from an allowed user or from an allowed chat
+type AccessPolicy struct {
+	AllowUsers IDList
+	AllowChats IDList
+	DenyUsers  IDList
+	DenyChats  IDList
+}
+
+// Allows reports whether the policy lets the update through
+func (p *AccessPolicy) Allows(u *Update) bool {
+	user, chat := u.EffectiveUser(), u.EffectiveChat()
+
+	if user != nil && p.DenyUsers != nil && p.DenyUsers.Contains(user.ID) {
+		return false
+	}
+	if chat != nil && p.DenyChats != nil && p.DenyChats.Contains(chat.ID) {
+		return false
+	}
+
+	if p.AllowUsers == nil && p.AllowChats == nil {
+		return true
+	}
+	if user != nil && p.AllowUsers != nil && p.AllowUsers.Contains(user.ID) {
+		return true
+	}
+	if chat != nil && p.AllowChats != nil && p.AllowChats.Contains(chat.ID) {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+/* admins.go : caching the administrators of chats
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * "Is this user an admin?" is asked for almost every message a moderation
+ * bot handles, and the answer rarely changes. AdminCache keeps the list of
+ * administrators of each chat, and forgets it when a chat_member update says
+ * that someone became or stopped being an administrator (or after a while,
+ * since those updates must be asked for explicitly in allowed_updates).
+ */
+
+package telegram
+
+import (
+	"context"
+	"time"
+)
+
+// Messages sent by anonymous administrators of a group come from this bot (@GroupAnonymousBot),
+// with the group itself as sender_chat. Only administrators can post anonymously
+const GroupAnonymousBotID = 1087968824
+
+// How long AdminCache keeps the administrators of a chat by default. Promotions and demotions
+// invalidate the list earlier, but only if the bot asks for chat_member updates
+const DefaultAdminCacheTTL = 10 * time.Minute
+
+// The function that fetches the administrators of a chat, usually a call to getChatAdministrators
+type AdminFetcher func(ctx context.Context, chatID int64) ([]ChatMember, error)
+
+// AdminCache answers IsAdmin from a cached list of administrators per chat. Pass it every update
+// with HandleUpdate so that promotions and demotions invalidate the list. It is safe for concurrent use
+type AdminCache struct {
+	fetch AdminFetcher
+	chats *ttlCache[int64, IDSet]
+}
+
+// NewAdminCache returns an AdminCache that calls fetch when a chat is not cached,
+// and keeps the list for ttl (DefaultAdminCacheTTL if ttl is 0)
+func NewAdminCache(ttl time.Duration, fetch AdminFetcher) *AdminCache {
+	return &AdminCache{fetch: fetch, chats: newTTLCache[int64, IDSet](ttl, DefaultAdminCacheTTL)}
+}
+
+// IsAdmin reports whether the user is the owner or an administrator of the chat.
+// GroupAnonymousBotID counts as an administrator, since it speaks for the anonymous ones
+func (c *AdminCache) IsAdmin(ctx context.Context, chatID, userID int64) (bool, error) {
+	if userID == GroupAnonymousBotID {
+		return true, nil
+	}
+
+	if ids, ok := c.chats.get(chatID); ok {
+		return ids.Contains(userID), nil
+	}
+
+	// Fetch without holding the lock: it's a network call. Two goroutines may fetch
+	// the same chat at the same time, which is harmless
+	members, err := c.fetch(ctx, chatID)
+	if err != nil {
+		return false, err
+	}
+	ids := make(IDSet, len(members))
+	for _, m := range members {
+		if m.IsAdmin() {
+			ids[m.User().ID] = true
+		}
+	}
+
+	c.chats.set(chatID, ids)
+	return ids.Contains(userID), nil
+}
+
+// Invalidate forgets the administrators of the chat, they will be fetched again when needed
+func (c *AdminCache) Invalidate(chatID int64) {
+	c.chats.delete(chatID)
+}
+
+// HandleUpdate invalidates the chat of a chat_member or my_chat_member update
+// if the member was or became an administrator. Other updates are ignored
+func (c *AdminCache) HandleUpdate(u *Update) {
+	for _, changed := range []*ChatMemberUpdated{u.ChatMember, u.MyChatMember} {
+		if changed != nil && (changed.OldChatMember.IsAdmin() || changed.NewChatMember.IsAdmin()) {
+			c.Invalidate(changed.Chat.ID)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+/* admins_test.go : tests for the cache of administrators
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+const (
+	testChat  = -1001234567890
+	testOwner = 1
+	testAdmin = 2
+	testUser  = 3
+)
+
+// An AdminCache over a fake getChatAdministrators that counts its calls, with a clock the test moves
+func newTestAdminCache(ttl time.Duration) (cache *AdminCache, calls *int, now *time.Time) {
+	calls = new(int)
+	now = new(time.Time)
+	*now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cache = NewAdminCache(ttl, func(ctx context.Context, chatID int64) ([]ChatMember, error) {
+		*calls++
+		return []ChatMember{
+			{Owner: &ChatMemberOwner{Status: "creator", User: User{ID: testOwner}}},
+			{Administrator: &ChatMemberAdministrator{Status: "administrator", User: User{ID: testAdmin}}},
+		}, nil
+	})
+	cache.chats.now = func() time.Time { return *now }
+	return cache, calls, now
+}
+
+func TestAdminCacheIsAdmin(t *testing.T) {
+	cache, calls, _ := newTestAdminCache(0)
+	tests := []struct {
+		name   string
+		userID int64
+		want   bool
+	}{
+		{"creator", testOwner, true},
+		{"administrator", testAdmin, true},
+		{"member", testUser, false},
+		{"anonymous administrator", GroupAnonymousBotID, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cache.IsAdmin(context.Background(), testChat, tt.userID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("IsAdmin(%d) = %v, want %v", tt.userID, got, tt.want)
+			}
+		})
+	}
+	if *calls != 1 {
+		t.Errorf("%d fetches, want 1: the first check is a miss, the others hits", *calls)
+	}
+}
+
+func TestAdminCacheExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		after     time.Duration
+		wantCalls int
+	}{
+		{name: "fresh", ttl: time.Minute, after: 59 * time.Second, wantCalls: 1},
+		{name: "expired", ttl: time.Minute, after: time.Minute, wantCalls: 2},
+		{name: "default ttl, fresh", after: DefaultAdminCacheTTL - time.Second, wantCalls: 1},
+		{name: "default ttl, expired", after: DefaultAdminCacheTTL, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache, calls, now := newTestAdminCache(tt.ttl)
+			if _, err := cache.IsAdmin(context.Background(), testChat, testAdmin); err != nil {
+				t.Fatal(err)
+			}
+			*now = now.Add(tt.after)
+			if _, err := cache.IsAdmin(context.Background(), testChat, testAdmin); err != nil {
+				t.Fatal(err)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("%d fetches, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestAdminCacheInvalidation(t *testing.T) {
+	admin := ChatMember{Administrator: &ChatMemberAdministrator{Status: "administrator", User: User{ID: testUser}}}
+	member := ChatMember{Member: &ChatMemberMember{Status: "member", User: User{ID: testUser}}}
+	left := ChatMember{Left: &ChatMemberLeft{Status: "left", User: User{ID: testUser}}}
+	tests := []struct {
+		name      string
+		update    Update
+		wantCalls int
+	}{
+		{
+			name:      "promotion",
+			update:    Update{ChatMember: &ChatMemberUpdated{Chat: Chat{ID: testChat}, OldChatMember: member, NewChatMember: admin}},
+			wantCalls: 2,
+		},
+		{
+			name:      "demotion of the bot",
+			update:    Update{MyChatMember: &ChatMemberUpdated{Chat: Chat{ID: testChat}, OldChatMember: admin, NewChatMember: member}},
+			wantCalls: 2,
+		},
+		{
+			name:      "a member leaves",
+			update:    Update{ChatMember: &ChatMemberUpdated{Chat: Chat{ID: testChat}, OldChatMember: member, NewChatMember: left}},
+			wantCalls: 1,
+		},
+		{
+			name:      "promotion in another chat",
+			update:    Update{ChatMember: &ChatMemberUpdated{Chat: Chat{ID: testChat - 1}, OldChatMember: member, NewChatMember: admin}},
+			wantCalls: 1,
+		},
+		{
+			name:      "a message",
+			update:    Update{Message: &Message{Chat: Chat{ID: testChat}}},
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache, calls, _ := newTestAdminCache(0)
+			if _, err := cache.IsAdmin(context.Background(), testChat, testAdmin); err != nil {
+				t.Fatal(err)
+			}
+			cache.HandleUpdate(&tt.update)
+			if _, err := cache.IsAdmin(context.Background(), testChat, testAdmin); err != nil {
+				t.Fatal(err)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("%d fetches, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+
+	t.Run("Invalidate", func(t *testing.T) {
+		cache, calls, _ := newTestAdminCache(0)
+		cache.IsAdmin(context.Background(), testChat, testAdmin)
+		cache.Invalidate(testChat)
+		cache.IsAdmin(context.Background(), testChat, testAdmin)
+		if *calls != 2 {
+			t.Errorf("%d fetches, want 2", *calls)
+		}
+	})
+}
+
+func TestAdminCacheFetchError(t *testing.T) {
+	calls := 0
+	fail := errors.New("network down")
+	cache := NewAdminCache(0, func(ctx context.Context, chatID int64) ([]ChatMember, error) {
+		calls++
+		return nil, fail
+	})
+	for range 2 {
+		if _, err := cache.IsAdmin(context.Background(), testChat, testAdmin); !errors.Is(err, fail) {
+			t.Fatalf("IsAdmin() error = %v, want %v", err, fail)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("%d fetches, want 2: errors aren't cached", calls)
+	}
+}
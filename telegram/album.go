@@ -0,0 +1,105 @@
+/* album.go : collecting the messages of an album
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * An album (media group) doesn't arrive as one update: each photo or video
+ * is a separate message with the same media_group_id, and nothing says which
+ * one is the last. The only way to get the whole album is to wait a little
+ * after each message and see if more come.
+ */
+
+package telegram
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+)
+
+// How long MediaGroupCollector waits for the next message of an album by default.
+// The messages of an album usually arrive within a fraction of a second of each other
+const DefaultMediaGroupDebounce = time.Second
+
+// MediaGroupCollector buffers the messages of albums and delivers each album as a whole, once no new
+// message of it has arrived for the debounce time. It is safe for concurrent use
+type MediaGroupCollector struct {
+	debounce time.Duration
+	deliver  func(album []*Message)
+
+	mu     sync.Mutex
+	albums map[string]*pendingAlbum
+}
+
+type pendingAlbum struct {
+	messages []*Message
+	timer    *time.Timer
+}
+
+// NewMediaGroupCollector returns a collector that calls deliver with the messages of each album,
+// ordered by MessageID. debounce is the wait after the latest message (DefaultMediaGroupDebounce if 0).
+// deliver is called from the goroutine of a timer, or from Flush
+func NewMediaGroupCollector(debounce time.Duration, deliver func(album []*Message)) *MediaGroupCollector {
+	if debounce == 0 {
+		debounce = DefaultMediaGroupDebounce
+	}
+	return &MediaGroupCollector{
+		debounce: debounce,
+		deliver:  deliver,
+		albums:   make(map[string]*pendingAlbum),
+	}
+}
+
+// Add buffers the message if it belongs to an album, and reports whether it did.
+// Messages without a MediaGroupID are not touched: the caller handles them as usual
+func (c *MediaGroupCollector) Add(m *Message) bool {
+	if m.MediaGroupID == "" {
+		return false
+	}
+	key := m.MediaGroupID
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	album := c.albums[key]
+	if album == nil {
+		album = &pendingAlbum{}
+		c.albums[key] = album
+		album.timer = time.AfterFunc(c.debounce, func() { c.flush(key) })
+	} else {
+		album.timer.Reset(c.debounce)
+	}
+	album.messages = append(album.messages, m)
+	return true
+}
+
+// Flush delivers all the buffered albums now, e.g. before shutting down
+func (c *MediaGroupCollector) Flush() {
+	c.mu.Lock()
+	var keys []string
+	for key, album := range c.albums {
+		if album.timer.Stop() {
+			keys = append(keys, key)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, key := range keys {
+		c.flush(key)
+	}
+}
+
+func (c *MediaGroupCollector) flush(key string) {
+	c.mu.Lock()
+	album := c.albums[key]
+	delete(c.albums, key)
+	c.mu.Unlock()
+	if album == nil {
+		return
+	}
+
+	slices.SortFunc(album.messages, func(a, b *Message) int {
+		return cmp.Compare(a.MessageID, b.MessageID)
+	})
+	c.deliver(album.messages)
+}
@@ -0,0 +1,103 @@
+/* album_test.go : tests for collecting the messages of an album
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func albumPhoto(id int64, group string) *Message {
+	return &Message{
+		MessageID:    id,
+		Chat:         Chat{ID: 7, Type: "private"},
+		MediaGroupID: group,
+		Photo:        []PhotoSize{{FileID: "photo" + strconv.FormatInt(id, 10)}},
+	}
+}
+
+func TestMediaGroupCollectorAlbum(t *testing.T) {
+	albums := make(chan []*Message, 4)
+	c := NewMediaGroupCollector(50*time.Millisecond, func(album []*Message) { albums <- album })
+
+	if c.Add(&Message{MessageID: 1, Text: "not an album"}) {
+		t.Error("a message without media_group_id was buffered")
+	}
+
+	// A 3-photo album, whose updates arrive out of order and not all at once
+	start := time.Now()
+	for _, id := range []int64{11, 10, 12} {
+		if !c.Add(albumPhoto(id, "album1")) {
+			t.Fatalf("photo %d was not buffered", id)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case album := <-albums:
+		if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+			t.Errorf("album delivered after %v, before the debounce", elapsed)
+		}
+		if len(album) != 3 {
+			t.Fatalf("album has %d messages, want 3", len(album))
+		}
+		for i, m := range album {
+			if m.MessageID != int64(10+i) {
+				t.Errorf("message %d is %d, want %d: the album must be ordered by MessageID", i, m.MessageID, 10+i)
+			}
+		}
+	case <-time.After(time.Second):
+		t.Fatal("album never delivered")
+	}
+
+	select {
+	case album := <-albums:
+		t.Errorf("unexpected second delivery: %d messages", len(album))
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestMediaGroupCollectorSeparateAlbums(t *testing.T) {
+	albums := make(chan []*Message, 4)
+	c := NewMediaGroupCollector(30*time.Millisecond, func(album []*Message) { albums <- album })
+	c.Add(albumPhoto(1, "a"))
+	c.Add(albumPhoto(5, "b"))
+	c.Add(albumPhoto(2, "a"))
+	c.Add(albumPhoto(6, "b"))
+	c.Add(albumPhoto(3, "a"))
+
+	got := map[string]int{}
+	for range 2 {
+		select {
+		case album := <-albums:
+			got[album[0].MediaGroupID] = len(album)
+		case <-time.After(time.Second):
+			t.Fatal("album never delivered")
+		}
+	}
+	if got["a"] != 3 || got["b"] != 2 {
+		t.Errorf("delivered %v, want a:3 b:2", got)
+	}
+}
+
+func TestMediaGroupCollectorFlush(t *testing.T) {
+	var delivered [][]*Message
+	c := NewMediaGroupCollector(time.Hour, func(album []*Message) { delivered = append(delivered, album) })
+	c.Add(albumPhoto(1, "a"))
+	c.Add(albumPhoto(2, "a"))
+	c.Add(albumPhoto(3, "a"))
+
+	c.Flush() // delivers on this goroutine, no need to wait
+	if len(delivered) != 1 || len(delivered[0]) != 3 {
+		t.Fatalf("Flush delivered %v", delivered)
+	}
+	c.Flush()
+	if len(delivered) != 1 {
+		t.Error("a second Flush delivered the album again")
+	}
+}
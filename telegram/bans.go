@@ -0,0 +1,96 @@
+/* bans.go : bans and the until_date of restrictions
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * banChatMember and restrictChatMember take an until_date in Unix time,
+ * with a catch: a date less than 30 seconds or more than 366 days away
+ * means "forever". A ban of 10 seconds is a permanent ban, so the
+ * Validate methods refuse dates in those ranges, except 0 (BanForever).
+ */
+
+package telegram
+
+import (
+	"fmt"
+	"time"
+)
+
+// The shortest and the longest temporary ban or restriction: below and above these
+// Telegram makes them permanent
+const (
+	MinRestrictionDuration = 30 * time.Second
+	MaxRestrictionDuration = 366 * 24 * time.Hour
+)
+
+// How far inside the limits BanFor moves the durations that are right at them.
+// The request takes some time to reach Telegram: a ban of exactly MinRestrictionDuration
+// would be a little shorter by then, and permanent
+const restrictionMargin = 5 * time.Second
+
+// BanFor returns the until_date of a ban or a restriction lasting d from now.
+// d must be between MinRestrictionDuration and MaxRestrictionDuration: shorter or longer
+// durations are permanent for Telegram, so Validate refuses them. Durations within a few
+// seconds of the limits are moved inside them, so BanFor(MinRestrictionDuration)
+// and BanFor(MaxRestrictionDuration) are safe to send
+func BanFor(d time.Duration) int64 {
+	switch {
+	case d >= MinRestrictionDuration && d < MinRestrictionDuration+restrictionMargin:
+		d = MinRestrictionDuration + restrictionMargin
+	case d <= MaxRestrictionDuration && d > MaxRestrictionDuration-restrictionMargin:
+		d = MaxRestrictionDuration - restrictionMargin
+	}
+	return BanUntil(time.Now().Add(d))
+}
+
+// BanUntil returns the until_date of a ban or a restriction lifted at t, rounded up to the second.
+// The same limits of BanFor apply to the time left until t
+func BanUntil(t time.Time) int64 {
+	until := t.Unix()
+	if t.Nanosecond() > 0 {
+		until++
+	}
+	return until
+}
+
+// BanForever returns the until_date of a permanent ban or restriction, 0
+func BanForever() int64 {
+	return 0
+}
+
+// UntilDateIsForever reports whether Telegram would read until as "forever" if sent at now:
+// 0, or a date less than MinRestrictionDuration or more than MaxRestrictionDuration away.
+// The limits themselves are not "forever"
+func UntilDateIsForever(until int64, now time.Time) bool {
+	if until == 0 {
+		return true
+	}
+	left := time.Unix(until, 0).Sub(now)
+	return left < MinRestrictionDuration || left > MaxRestrictionDuration
+}
+
+// A non-zero until_date that Telegram would read as "forever" is surely a mistake
+func validateUntilDate(until int64) error {
+	if until < 0 {
+		return fmt.Errorf("negative until_date %d", until)
+	}
+	if until != 0 && UntilDateIsForever(until, time.Now()) {
+		return fmt.Errorf("until_date %s is less than 30 seconds or more than 366 days away, Telegram would make it permanent (use BanForever for that)",
+			time.Unix(until, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Validate checks the parameters of banChatMember
+func (p *BanChatMemberParams) Validate() error {
+	if p.ChatID.IsZero() {
+		return fmt.Errorf("telegram: chat_id is required")
+	}
+	if p.UserID <= 0 {
+		return fmt.Errorf("telegram: invalid user_id %d", p.UserID)
+	}
+	if err := validateUntilDate(p.UntilDate); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+/* bans_test.go : tests for the until_date of bans and restrictions
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBanForValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		until   int64
+		wantErr bool
+	}{
+		{name: "forever", until: BanForever()},
+		{name: "shortest", until: BanFor(MinRestrictionDuration)},
+		{name: "just above the shortest", until: BanFor(MinRestrictionDuration + time.Second)},
+		{name: "one hour", until: BanFor(time.Hour)},
+		{name: "just below the longest", until: BanFor(MaxRestrictionDuration - time.Second)},
+		{name: "longest", until: BanFor(MaxRestrictionDuration)},
+		{name: "too short", until: BanFor(MinRestrictionDuration - time.Second), wantErr: true},
+		{name: "ten seconds", until: BanFor(10 * time.Second), wantErr: true},
+		{name: "too long", until: BanFor(MaxRestrictionDuration + time.Second), wantErr: true},
+		{name: "in the past", until: BanFor(-time.Hour), wantErr: true},
+		{name: "negative", until: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := BanChatMemberParams{ChatID: NewChatID(-1001234567890), UserID: 42, UntilDate: tt.until}
+			if err := p.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBanForStaysInside(t *testing.T) {
+	now := time.Now()
+	if left := time.Unix(BanFor(MinRestrictionDuration), 0).Sub(now); left < MinRestrictionDuration+restrictionMargin-time.Second {
+		t.Errorf("BanFor(MinRestrictionDuration) is %v away, too close to the limit", left)
+	}
+	if left := time.Unix(BanFor(MaxRestrictionDuration), 0).Sub(now); left > MaxRestrictionDuration-restrictionMargin+time.Second {
+		t.Errorf("BanFor(MaxRestrictionDuration) is %v away, too close to the limit", left)
+	}
+	if got, want := BanFor(time.Hour), BanUntil(now.Add(time.Hour)); got < want || got > want+1 {
+		t.Errorf("BanFor(time.Hour) = %d, want about %d", got, want)
+	}
+}
+
+func TestUntilDateIsForever(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name  string
+		until int64
+		want  bool
+	}{
+		{name: "zero", until: 0, want: true},
+		{name: "29 seconds", until: now.Add(29 * time.Second).Unix(), want: true},
+		{name: "30 seconds", until: now.Add(MinRestrictionDuration).Unix(), want: false},
+		{name: "366 days", until: now.Add(MaxRestrictionDuration).Unix(), want: false},
+		{name: "366 days and a second", until: now.Add(MaxRestrictionDuration + time.Second).Unix(), want: true},
+		{name: "in the past", until: now.Add(-time.Hour).Unix(), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UntilDateIsForever(tt.until, now); got != tt.want {
+				t.Errorf("UntilDateIsForever() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBanUntilRoundsUp(t *testing.T) {
+	if got := BanUntil(time.Unix(1700000000, 0)); got != 1700000000 {
+		t.Errorf("BanUntil(whole second) = %d, want 1700000000", got)
+	}
+	if got := BanUntil(time.Unix(1700000000, 1)); got != 1700000001 {
+		t.Errorf("BanUntil(1ns past) = %d, want 1700000001", got)
+	}
+}
@@ -0,0 +1,165 @@
+/* business.go : managing business accounts
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Limits of the business account methods
+const (
+	MaxBusinessGiftsLimit   = 100
+	MaxBusinessStarTransfer = 10000
+	MaxBusinessNameLength   = 64
+	MaxBusinessBioLength    = 140
+)
+
+// All the business account methods act on behalf of a business connection
+func validateBusinessConnection(id string) error {
+	if id == "" {
+		return fmt.Errorf("business_connection_id is required")
+	}
+	return nil
+}
+
+// Validate checks the parameters of getBusinessAccountGifts
+func (p *GetBusinessAccountGiftsParams) Validate() error {
+	if err := validateBusinessConnection(p.BusinessConnectionID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.Limit < 0 || p.Limit > MaxBusinessGiftsLimit {
+		return fmt.Errorf("telegram: limit must be 1-%d, got %d", MaxBusinessGiftsLimit, p.Limit)
+	}
+	return nil
+}
+
+// Validate checks the parameters of getBusinessAccountStarBalance
+func (p *GetBusinessAccountStarBalanceParams) Validate() error {
+	if err := validateBusinessConnection(p.BusinessConnectionID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of transferBusinessAccountStars
+func (p *TransferBusinessAccountStarsParams) Validate() error {
+	if err := validateBusinessConnection(p.BusinessConnectionID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.StarCount < 1 || p.StarCount > MaxBusinessStarTransfer {
+		return fmt.Errorf("telegram: star_count must be 1-%d, got %d", MaxBusinessStarTransfer, p.StarCount)
+	}
+	return nil
+}
+
+// Validate checks the parameters of setBusinessAccountName
+func (p *SetBusinessAccountNameParams) Validate() error {
+	if err := validateBusinessConnection(p.BusinessConnectionID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if strings.TrimSpace(p.FirstName) == "" {
+		return fmt.Errorf("telegram: first_name is empty")
+	}
+	if err := checkLength("first_name", p.FirstName, ParseModeNone, MaxBusinessNameLength); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := checkLength("last_name", p.LastName, ParseModeNone, MaxBusinessNameLength); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of setBusinessAccountUsername
+func (p *SetBusinessAccountUsernameParams) Validate() error {
+	if err := validateBusinessConnection(p.BusinessConnectionID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	// Empty removes the username, otherwise it follows the usual rules
+	if p.Username != "" && !isValidUsername(p.Username) {
+		return fmt.Errorf("telegram: invalid username %q", p.Username)
+	}
+	return nil
+}
+
+// Validate checks the parameters of setBusinessAccountBio
+func (p *SetBusinessAccountBioParams) Validate() error {
+	if err := validateBusinessConnection(p.BusinessConnectionID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := checkLength("bio", p.Bio, ParseModeNone, MaxBusinessBioLength); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// GetBusinessAccountGifts calls getBusinessAccountGifts and returns one page of gifts:
+// pass NextOffset as Offset to get the next one
+func GetBusinessAccountGifts(ctx context.Context, client *http.Client, token string, params GetBusinessAccountGiftsParams) (*OwnedGifts, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	gifts, err := Call[OwnedGifts](ctx, client, token, "getBusinessAccountGifts", &params)
+	if err != nil {
+		return nil, err
+	}
+	return &gifts, nil
+}
+
+// GetBusinessAccountStarBalance calls getBusinessAccountStarBalance
+func GetBusinessAccountStarBalance(ctx context.Context, client *http.Client, token, businessConnectionID string) (*StarAmount, error) {
+	params := GetBusinessAccountStarBalanceParams{BusinessConnectionID: businessConnectionID}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	balance, err := Call[StarAmount](ctx, client, token, "getBusinessAccountStarBalance", &params)
+	if err != nil {
+		return nil, err
+	}
+	return &balance, nil
+}
+
+// TransferBusinessAccountStars calls transferBusinessAccountStars
+func TransferBusinessAccountStars(ctx context.Context, client *http.Client, token, businessConnectionID string, starCount int64) error {
+	params := TransferBusinessAccountStarsParams{BusinessConnectionID: businessConnectionID, StarCount: starCount}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "transferBusinessAccountStars", &params)
+	return err
+}
+
+// SetBusinessAccountName calls setBusinessAccountName
+func SetBusinessAccountName(ctx context.Context, client *http.Client, token string, params SetBusinessAccountNameParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setBusinessAccountName", &params)
+	return err
+}
+
+// SetBusinessAccountUsername calls setBusinessAccountUsername. An empty username removes it
+func SetBusinessAccountUsername(ctx context.Context, client *http.Client, token, businessConnectionID, username string) error {
+	params := SetBusinessAccountUsernameParams{BusinessConnectionID: businessConnectionID, Username: username}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setBusinessAccountUsername", &params)
+	return err
+}
+
+// SetBusinessAccountBio calls setBusinessAccountBio. An empty bio removes it
+func SetBusinessAccountBio(ctx context.Context, client *http.Client, token, businessConnectionID, bio string) error {
+	params := SetBusinessAccountBioParams{BusinessConnectionID: businessConnectionID, Bio: bio}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setBusinessAccountBio", &params)
+	return err
+}
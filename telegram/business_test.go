@@ -0,0 +1,155 @@
+/* business_test.go : tests for managing business accounts
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBusinessAccountGetters(t *testing.T) {
+	var gotMethod, gotParams string
+	client := fakeClient(func(method string, params []byte) string {
+		gotMethod, gotParams = method, string(params)
+		switch method {
+		case "getBusinessAccountGifts":
+			return `{"ok":true,"result":{"total_count":3,"gifts":[{"type":"regular","send_date":1700000000},{"type":"unique","send_date":1700000001}],"next_offset":"2"}}`
+		case "getBusinessAccountStarBalance":
+			return `{"ok":true,"result":{"amount":150,"nanostar_amount":500000000}}`
+		}
+		return `{"ok":false,"error_code":404,"description":"Not Found"}`
+	})
+
+	gifts, err := GetBusinessAccountGifts(context.Background(), client, "123:abc", GetBusinessAccountGiftsParams{BusinessConnectionID: "bc", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gifts.TotalCount != 3 || len(gifts.Gifts) != 2 || gifts.NextOffset != "2" || !strings.Contains(string(gifts.Gifts[1]), `"unique"`) {
+		t.Errorf("GetBusinessAccountGifts() = %+v", gifts)
+	}
+	if gotMethod != "getBusinessAccountGifts" || gotParams != `{"business_connection_id":"bc","limit":2}` {
+		t.Errorf("called %s with %s", gotMethod, gotParams)
+	}
+
+	balance, err := GetBusinessAccountStarBalance(context.Background(), client, "123:abc", "bc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Amount != 150 || balance.NanostarAmount != 500000000 {
+		t.Errorf("GetBusinessAccountStarBalance() = %+v", balance)
+	}
+
+	if _, err := GetBusinessAccountStarBalance(context.Background(), client, "123:abc", ""); err == nil {
+		t.Error("GetBusinessAccountStarBalance() without business connection succeeded")
+	}
+	if _, err := GetBusinessAccountGifts(context.Background(), client, "123:abc", GetBusinessAccountGiftsParams{BusinessConnectionID: "bc", Limit: 101}); err == nil {
+		t.Error("GetBusinessAccountGifts() with limit 101 succeeded")
+	}
+}
+
+func TestBusinessAccountSetters(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(client *http.Client) error
+		wantMethod string
+		wantParams string
+		wantErr    bool
+	}{
+		{
+			name: "transfer stars",
+			call: func(c *http.Client) error {
+				return TransferBusinessAccountStars(context.Background(), c, "123:abc", "bc", 100)
+			},
+			wantMethod: "transferBusinessAccountStars",
+			wantParams: `{"business_connection_id":"bc","star_count":100}`,
+		},
+		{
+			name: "transfer too many stars",
+			call: func(c *http.Client) error {
+				return TransferBusinessAccountStars(context.Background(), c, "123:abc", "bc", 10001)
+			},
+			wantErr: true,
+		},
+		{
+			name: "name",
+			call: func(c *http.Client) error {
+				return SetBusinessAccountName(context.Background(), c, "123:abc", SetBusinessAccountNameParams{BusinessConnectionID: "bc", FirstName: "Ann", LastName: "Lee"})
+			},
+			wantMethod: "setBusinessAccountName",
+			wantParams: `{"business_connection_id":"bc","first_name":"Ann","last_name":"Lee"}`,
+		},
+		{
+			name: "name too long",
+			call: func(c *http.Client) error {
+				return SetBusinessAccountName(context.Background(), c, "123:abc", SetBusinessAccountNameParams{BusinessConnectionID: "bc", FirstName: strings.Repeat("a", 65)})
+			},
+			wantErr: true,
+		},
+		{
+			name: "username",
+			call: func(c *http.Client) error {
+				return SetBusinessAccountUsername(context.Background(), c, "123:abc", "bc", "ann_shop")
+			},
+			wantMethod: "setBusinessAccountUsername",
+			wantParams: `{"business_connection_id":"bc","username":"ann_shop"}`,
+		},
+		{
+			name: "remove username",
+			call: func(c *http.Client) error {
+				return SetBusinessAccountUsername(context.Background(), c, "123:abc", "bc", "")
+			},
+			wantMethod: "setBusinessAccountUsername",
+			wantParams: `{"business_connection_id":"bc"}`,
+		},
+		{
+			name: "invalid username",
+			call: func(c *http.Client) error {
+				return SetBusinessAccountUsername(context.Background(), c, "123:abc", "bc", "a b")
+			},
+			wantErr: true,
+		},
+		{
+			name: "bio",
+			call: func(c *http.Client) error {
+				return SetBusinessAccountBio(context.Background(), c, "123:abc", "bc", "Open 9-18")
+			},
+			wantMethod: "setBusinessAccountBio",
+			wantParams: `{"business_connection_id":"bc","bio":"Open 9-18"}`,
+		},
+		{
+			name: "bio too long",
+			call: func(c *http.Client) error {
+				return SetBusinessAccountBio(context.Background(), c, "123:abc", "bc", strings.Repeat("b", 141))
+			},
+			wantErr: true,
+		},
+		{
+			name: "no business connection",
+			call: func(c *http.Client) error {
+				return SetBusinessAccountBio(context.Background(), c, "123:abc", "", "bio")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod, gotParams string
+			client := fakeClient(func(method string, params []byte) string {
+				gotMethod, gotParams = method, string(params)
+				return `{"ok":true,"result":true}`
+			})
+			if err := tt.call(client); (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotMethod != tt.wantMethod || gotParams != tt.wantParams {
+				t.Errorf("called %q with %s, want %q with %s", gotMethod, gotParams, tt.wantMethod, tt.wantParams)
+			}
+		})
+	}
+}
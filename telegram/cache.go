@@ -0,0 +1,66 @@
+/* cache.go : the expiring map behind the caches of this package
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * AdminCache, RightsCache, FileCache and ChatCache all keep the results of
+ * some call for a while. They share ttlCache, so that a ttl means the same
+ * thing everywhere: 0 is the default of the cache, never "forever".
+ */
+
+package telegram
+
+import (
+	"sync"
+	"time"
+)
+
+// A map whose entries expire ttl after being set. It is safe for concurrent use
+type ttlCache[K comparable, V any] struct {
+	ttl time.Duration
+	now func() time.Time // time.Now, replaced by the tests
+
+	mu      sync.Mutex
+	entries map[K]ttlEntry[V]
+}
+
+type ttlEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// Returns a ttlCache keeping its entries for ttl, or for def if ttl is 0
+func newTTLCache[K comparable, V any](ttl, def time.Duration) *ttlCache[K, V] {
+	if ttl == 0 {
+		ttl = def
+	}
+	return &ttlCache[K, V]{ttl: ttl, now: time.Now, entries: make(map[K]ttlEntry[V])}
+}
+
+// Returns the value of key, if it is there and it hasn't expired. Expired entries are removed
+func (c *ttlCache[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if ok && !c.now().Before(e.expires) {
+		delete(c.entries, key)
+		ok = false
+	}
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+func (c *ttlCache[K, V]) set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = ttlEntry[V]{value: value, expires: c.now().Add(c.ttl)}
+}
+
+func (c *ttlCache[K, V]) delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
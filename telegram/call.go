@@ -0,0 +1,154 @@
+/* call.go : calling any method of the Bot API
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Telegram adds methods faster than any library can wrap them. Call is the
+ * escape hatch: it sends whatever parameters to whatever method and decodes
+ * the result into the type the caller asks for.
+ */
+
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Where the Bot API lives. Methods are at /bot<token>/<method>, files at /file/bot<token>/<file_path>
+const apiBaseURL = "https://api.telegram.org"
+
+// Call sends params as JSON to the Bot API method and decodes its result into a T.
+// Use it for methods that don't have their own Params type yet, e.g.
+//
+//	me, err := telegram.Call[telegram.User](ctx, nil, token, "getMe", nil)
+//
+// params can be nil for methods without parameters, and a nil client means http.DefaultClient.
+// Failures reported by Telegram are returned as *APIError
+func Call[T any](ctx context.Context, client *http.Client, token, method string, params any) (T, error) {
+	var result T
+	data, err := post(ctx, client, token, method, params)
+	if err != nil {
+		return result, err
+	}
+	if err := DecodeResponse(data, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// Call for the methods that return True on success (setChatTitle, deleteMessages, ...).
+// The answer is checked with DecodeBoolResponse: the result is true only for {"ok":true,"result":true},
+// anything else is an error. That's why the wrappers return just the error
+func callBool(ctx context.Context, client *http.Client, token, method string, params any) (bool, error) {
+	data, err := post(ctx, client, token, method, params)
+	if err != nil {
+		return false, err
+	}
+	return DecodeBoolResponse(data)
+}
+
+// Sends params as JSON to the method and returns the body of the answer, whatever its status
+func post(ctx context.Context, client *http.Client, token, method string, params any) ([]byte, error) {
+	body := []byte("{}")
+	if params != nil {
+		var err error
+		if body, err = json.Marshal(params); err != nil {
+			return nil, fmt.Errorf("telegram: encoding parameters of %s: %w", method, err)
+		}
+	}
+	return send(ctx, client, token, method, bytes.NewReader(body), "application/json")
+}
+
+// InputFile is a file to upload with multipart/form-data, e.g. the certificate of setWebhook
+type InputFile struct {
+	// The file name sent to Telegram
+	Name string
+
+	// The content of the file, read once while sending the request
+	Reader io.Reader
+}
+
+// Like post, but sends params as multipart/form-data along with files, which are keyed by the name of their field.
+// Each parameter becomes a form field: strings are sent as they are, everything else as JSON
+func postMultipart(ctx context.Context, client *http.Client, token, method string, params any, files map[string]*InputFile) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err == nil {
+			err = json.Unmarshal(data, &fields)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("telegram: encoding parameters of %s: %w", method, err)
+		}
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for name, value := range fields {
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			s = string(value)
+		}
+		if err := w.WriteField(name, s); err != nil {
+			return nil, fmt.Errorf("telegram: encoding parameters of %s: %w", method, err)
+		}
+	}
+	for name, file := range files {
+		part, err := w.CreateFormFile(name, file.Name)
+		if err == nil {
+			_, err = io.Copy(part, file.Reader)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("telegram: uploading %s of %s: %w", name, method, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("telegram: encoding parameters of %s: %w", method, err)
+	}
+	return send(ctx, client, token, method, &body, w.FormDataContentType())
+}
+
+// Sends the request body to the method and returns the body of the answer, whatever its status
+func send(ctx context.Context, client *http.Client, token, method string, body io.Reader, contentType string) ([]byte, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiBaseURL+"/bot"+token+"/"+method, body)
+	if err != nil {
+		return nil, fmt.Errorf("telegram: %w", redactToken(err, token))
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", DefaultUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("telegram: calling %s: %w", method, redactToken(err, token))
+	}
+	defer resp.Body.Close()
+
+	// Errors come with a status other than 200 but still have the usual JSON body, so don't look at the status
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("telegram: reading answer of %s: %w", method, err)
+	}
+	return data, nil
+}
+
+// The errors of net/http contain the URL, and so the token: hide it before the error ends up in a log
+func redactToken(err error, token string) error {
+	var urlErr *url.Error
+	if token != "" && errors.As(err, &urlErr) {
+		urlErr.URL = strings.ReplaceAll(urlErr.URL, token, "<token>")
+	}
+	return err
+}
@@ -0,0 +1,125 @@
+/* call_test.go : tests for Call and callBool, and the fake Bot API used by the tests of the helpers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// A client that never leaves the process: each request is answered with the JSON
+// returned by respond, given the name of the method and the JSON of the parameters
+func fakeClient(respond func(method string, params []byte) string) *http.Client {
+	return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		params, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(respond(path.Base(req.URL.Path), params))),
+			Request:    req,
+		}, nil
+	})}
+}
+
+func TestCallBool(t *testing.T) {
+	tests := []struct {
+		name    string
+		answer  string
+		want    bool
+		wantErr bool
+		wantAPI bool
+	}{
+		{name: "true", answer: `{"ok":true,"result":true}`, want: true},
+		{name: "false", answer: `{"ok":true,"result":false}`, wantErr: true},
+		{name: "object", answer: `{"ok":true,"result":{"message_id":1}}`, wantErr: true},
+		{name: "api error", answer: `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`, wantErr: true, wantAPI: true},
+		{name: "not json", answer: `<html>`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod, gotParams string
+			client := fakeClient(func(method string, params []byte) string {
+				gotMethod, gotParams = method, string(params)
+				return tt.answer
+			})
+			params := SetChatTitleParams{ChatID: ChatID{ID: -1001234567890}, Title: "New title"}
+			got, err := callBool(context.Background(), client, "123:abc", "setChatTitle", &params)
+			if got != tt.want || (err != nil) != tt.wantErr {
+				t.Fatalf("callBool() = %v, %v, want %v, wantErr %v", got, err, tt.want, tt.wantErr)
+			}
+			var apiErr *APIError
+			if got := errors.As(err, &apiErr); got != tt.wantAPI {
+				t.Errorf("error is an APIError: %v, want %v", got, tt.wantAPI)
+			}
+			if gotMethod != "setChatTitle" {
+				t.Errorf("method = %q, want setChatTitle", gotMethod)
+			}
+			if !strings.Contains(gotParams, `"title":"New title"`) {
+				t.Errorf("params = %s", gotParams)
+			}
+
+			// The wrappers don't hide a false: it is an error already
+			if err := SetChatTitle(context.Background(), client, "123:abc", params); (err != nil) != tt.wantErr {
+				t.Errorf("SetChatTitle() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCallGetMe(t *testing.T) {
+	var gotMethod, gotParams string
+	client := fakeClient(func(method string, params []byte) string {
+		gotMethod, gotParams = method, string(params)
+		return `{"ok":true,"result":{"id":42,"is_bot":true,"first_name":"Test","username":"test_bot","can_join_groups":true}}`
+	})
+	me, err := Call[User](context.Background(), client, "123:abc", "getMe", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if me.ID != 42 || !me.IsBot || me.Username != "test_bot" || !me.CanJoinGroups {
+		t.Errorf("getMe = %+v", me)
+	}
+	if gotMethod != "getMe" || gotParams != "{}" {
+		t.Errorf("called %s with %s, want getMe with {}", gotMethod, gotParams)
+	}
+}
+
+func TestCallErrors(t *testing.T) {
+	t.Run("api error", func(t *testing.T) {
+		client := fakeClient(func(string, []byte) string {
+			return `{"ok":false,"error_code":401,"description":"Unauthorized"}`
+		})
+		_, err := Call[User](context.Background(), client, "123:abc", "getMe", nil)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.ErrorCode != 401 {
+			t.Errorf("Call() = %v, want an APIError 401", err)
+		}
+	})
+	t.Run("token redacted", func(t *testing.T) {
+		client := &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		})}
+		_, err := Call[User](context.Background(), client, "123:secret", "getMe", nil)
+		if err == nil || strings.Contains(err.Error(), "secret") {
+			t.Errorf("Call() = %v, want an error without the token", err)
+		}
+	})
+}
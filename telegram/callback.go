@@ -0,0 +1,67 @@
+/* callback.go : answering callback queries
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"unicode/utf8"
+)
+
+// Maximum length of the text of a callback query answer, in characters
+const MaxCallbackAnswerLength = 200
+
+// NewCallbackAck returns the parameters of the simplest answer: no text at all,
+// just to stop the loading spinner on the button. Every callback query must be
+// answered, even when there is nothing to say
+func NewCallbackAck(callbackQueryID string) AnswerCallbackQueryParams {
+	return AnswerCallbackQueryParams{CallbackQueryID: callbackQueryID}
+}
+
+// NewCallbackAlert returns the parameters to answer with an alert the user must dismiss
+func NewCallbackAlert(callbackQueryID, text string) AnswerCallbackQueryParams {
+	return AnswerCallbackQueryParams{CallbackQueryID: callbackQueryID, Text: text, ShowAlert: true}
+}
+
+// Validate checks the parameters of answerCallbackQuery
+func (p *AnswerCallbackQueryParams) Validate() error {
+	if p.CallbackQueryID == "" {
+		return fmt.Errorf("telegram: callback_query_id is required")
+	}
+	if n := utf8.RuneCountInString(p.Text); n > MaxCallbackAnswerLength {
+		return fmt.Errorf("telegram: callback answer is %d characters long, the limit is %d", n, MaxCallbackAnswerLength)
+	}
+	if p.ShowAlert && p.Text == "" {
+		return fmt.Errorf("telegram: an alert needs a text")
+	}
+	return nil
+}
+
+// AnswerCallbackQuery calls answerCallbackQuery. A query that is too old (or already answered) is not
+// an error: the spinner on the button has gone away by itself, so the result is the same.
+// Bots should answer every callback query, even with just NewCallbackAck, to stop the spinner
+func AnswerCallbackQuery(ctx context.Context, client *http.Client, token string, params AnswerCallbackQueryParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "answerCallbackQuery", &params)
+	if IsQueryTooOld(err) {
+		return nil
+	}
+	return err
+}
+
+// AckCallback answers the callback query with NewCallbackAck, only to stop the spinner on the button
+func AckCallback(ctx context.Context, client *http.Client, token, callbackQueryID string) error {
+	return AnswerCallbackQuery(ctx, client, token, NewCallbackAck(callbackQueryID))
+}
+
+// AnswerCallbackAlert answers the callback query with an alert showing text, see NewCallbackAlert
+func AnswerCallbackAlert(ctx context.Context, client *http.Client, token, callbackQueryID, text string) error {
+	return AnswerCallbackQuery(ctx, client, token, NewCallbackAlert(callbackQueryID, text))
+}
@@ -0,0 +1,81 @@
+/* callback_test.go : tests for answering callback queries
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestAnswerCallbackShorthands(t *testing.T) {
+	tests := []struct {
+		name    string
+		answer  func(ctx context.Context, client *http.Client) error
+		want    AnswerCallbackQueryParams
+		wantErr bool
+	}{
+		{
+			name:   "ack",
+			answer: func(ctx context.Context, client *http.Client) error { return AckCallback(ctx, client, "123:abc", "q1") },
+			want:   AnswerCallbackQueryParams{CallbackQueryID: "q1"},
+		},
+		{
+			name: "alert",
+			answer: func(ctx context.Context, client *http.Client) error {
+				return AnswerCallbackAlert(ctx, client, "123:abc", "q2", "Not allowed")
+			},
+			want: AnswerCallbackQueryParams{CallbackQueryID: "q2", Text: "Not allowed", ShowAlert: true},
+		},
+		{
+			name: "alert without text",
+			answer: func(ctx context.Context, client *http.Client) error {
+				return AnswerCallbackAlert(ctx, client, "123:abc", "q3", "")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got AnswerCallbackQueryParams
+			calls := 0
+			client := fakeClient(func(method string, params []byte) string {
+				calls++
+				if method != "answerCallbackQuery" {
+					t.Errorf("method = %q", method)
+				}
+				if err := json.Unmarshal(params, &got); err != nil {
+					t.Error(err)
+				}
+				return `{"ok":true,"result":true}`
+			})
+			err := tt.answer(context.Background(), client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if calls != 0 {
+					t.Error("invalid parameters were sent")
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("sent %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnswerCallbackQueryTooOld(t *testing.T) {
+	client := fakeClient(func(string, []byte) string {
+		return `{"ok":false,"error_code":400,"description":"Bad Request: query is too old and response timeout expired or query ID is invalid"}`
+	})
+	if err := AckCallback(context.Background(), client, "123:abc", "q1"); err != nil {
+		t.Errorf("AckCallback() = %v, want nil for a query too old", err)
+	}
+}
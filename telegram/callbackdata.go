@@ -0,0 +1,77 @@
+/* callbackdata.go : encoding typed values into callback_data
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * The callback_data of an inline button is just a string of 1-64 bytes, so bots
+ * end up formatting and parsing things like "vote:42:up" by hand. This codec does
+ * it for any value: the data is "<prefix>:<payload>", where the prefix tells which
+ * kind of button was pressed and the payload is the value as base64url-encoded JSON.
+ */
+
+package telegram
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Maximum length of InlineKeyboardButton.CallbackData, in bytes
+const MaxCallbackDataLength = 64
+
+// Separator between the prefix and the payload
+const callbackDataSeparator = ":"
+
+// PackCallbackData encodes v into a callback_data string starting with prefix.
+// If v is nil, the data is the prefix alone. It returns an error if the result
+// doesn't fit in MaxCallbackDataLength bytes: keep values small (short field
+// names via json tags help a lot)
+func PackCallbackData(prefix string, v any) (string, error) {
+	if prefix == "" || strings.Contains(prefix, callbackDataSeparator) {
+		return "", fmt.Errorf("telegram: callback data prefix must be non-empty and can't contain %q", callbackDataSeparator)
+	}
+
+	data := prefix
+	if v != nil {
+		payload, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("telegram: encoding callback data: %w", err)
+		}
+		data += callbackDataSeparator + base64.RawURLEncoding.EncodeToString(payload)
+	}
+
+	if len(data) > MaxCallbackDataLength {
+		return "", fmt.Errorf("telegram: callback data is %d bytes, the limit is %d", len(data), MaxCallbackDataLength)
+	}
+	return data, nil
+}
+
+// UnpackCallbackData decodes data produced by PackCallbackData into v and returns its prefix.
+// v can be nil if the caller only wants the prefix
+func UnpackCallbackData(data string, v any) (prefix string, err error) {
+	prefix, payload, hasPayload := strings.Cut(data, callbackDataSeparator)
+	if v == nil {
+		return prefix, nil
+	}
+	if !hasPayload {
+		return prefix, fmt.Errorf("telegram: callback data %q has no payload", data)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return prefix, fmt.Errorf("telegram: decoding callback data: %w", err)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return prefix, fmt.Errorf("telegram: decoding callback data: %w", err)
+	}
+	return prefix, nil
+}
+
+// CallbackDataPrefix returns the prefix of data produced by PackCallbackData,
+// which is what a handler routes on
+func CallbackDataPrefix(data string) string {
+	prefix, _, _ := strings.Cut(data, callbackDataSeparator)
+	return prefix
+}
@@ -0,0 +1,191 @@
+/* chat.go : chat helpers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// LinkedChatID returns the identifier of the linked chat: the discussion group of a channel,
+// or the channel of a discussion group. The boolean is false if there is no linked chat
+func (c *ChatFullInfo) LinkedChatID() (int64, bool) {
+	return c.RawLinkedChatID, c.RawLinkedChatID != 0
+}
+
+// LinkedChat returns the linked chat as a ChatID, ready to be passed to getChat.
+// The boolean is false if there is no linked chat
+func (c *ChatFullInfo) LinkedChat() (ChatID, bool) {
+	if c.RawLinkedChatID == 0 {
+		return ChatID{}, false
+	}
+	return NewChatID(c.RawLinkedChatID), true
+}
+
+// AllowsReaction reports whether the reaction can be set on messages of the chat, so that a bot
+// can check it before setMessageReaction. When the chat doesn't restrict reactions only the
+// emoji ones are known to be allowed
+func (c *ChatFullInfo) AllowsReaction(r ReactionType) bool {
+	if c.AvailableReactions == nil {
+		return r.Emoji != nil
+	}
+	for _, a := range c.AvailableReactions {
+		switch {
+		case r.Emoji != nil && a.Emoji != nil:
+			if r.Emoji.Emoji == a.Emoji.Emoji {
+				return true
+			}
+		case r.CustomEmoji != nil && a.CustomEmoji != nil:
+			if r.CustomEmoji.CustomEmojiID == a.CustomEmoji.CustomEmojiID {
+				return true
+			}
+		case r.Paid != nil && a.Paid != nil:
+			return true
+		}
+	}
+	return false
+}
+
+// GetChat calls getChat. To call it often for the same chats, use a ChatCache
+func GetChat(ctx context.Context, client *http.Client, token string, chatID ChatID) (*ChatFullInfo, error) {
+	if chatID.IsZero() {
+		return nil, fmt.Errorf("telegram: chat_id is required")
+	}
+	chat, err := Call[ChatFullInfo](ctx, client, token, "getChat", &GetChatParams{ChatID: chatID})
+	if err != nil {
+		return nil, err
+	}
+	return &chat, nil
+}
+
+// GetChatMemberCount calls getChatMemberCount
+func GetChatMemberCount(ctx context.Context, client *http.Client, token string, chatID ChatID) (int64, error) {
+	if chatID.IsZero() {
+		return 0, fmt.Errorf("telegram: chat_id is required")
+	}
+	return Call[int64](ctx, client, token, "getChatMemberCount", &GetChatMemberCountParams{ChatID: chatID})
+}
+
+// GetChatAdministrators calls getChatAdministrators. The result includes the owner but no bots
+func GetChatAdministrators(ctx context.Context, client *http.Client, token string, chatID ChatID) ([]ChatMember, error) {
+	if chatID.IsZero() {
+		return nil, fmt.Errorf("telegram: chat_id is required")
+	}
+	return Call[[]ChatMember](ctx, client, token, "getChatAdministrators", &GetChatAdministratorsParams{ChatID: chatID})
+}
+
+// ResolveUsername calls getChat with a public username (with or without the "@"), as typed by a user,
+// after checking that it is a valid username. Only public supergroups, channels and bots can be resolved:
+// a username that doesn't exist, or belongs to a user, gives an error that IsChatNotFound recognizes
+func ResolveUsername(ctx context.Context, client *http.Client, token, username string) (*ChatFullInfo, error) {
+	username = strings.TrimPrefix(strings.TrimSpace(username), "@")
+	if !isValidUsername(username) {
+		return nil, fmt.Errorf("telegram: invalid username %q", username)
+	}
+	chat, err := Call[ChatFullInfo](ctx, client, token, "getChat", &GetChatParams{ChatID: NewChatUsername(username)})
+	if err != nil {
+		if IsChatNotFound(err) {
+			return nil, fmt.Errorf("telegram: no chat with username @%s: %w", username, err)
+		}
+		return nil, err
+	}
+	return &chat, nil
+}
+
+// Limits of the title and the description of a chat, in characters
+const (
+	MaxChatTitleLength       = 128
+	MaxChatDescriptionLength = 255
+)
+
+// Validate checks the parameters of setChatTitle
+func (p *SetChatTitleParams) Validate() error {
+	if p.ChatID.IsZero() {
+		return fmt.Errorf("telegram: chat_id is required")
+	}
+	if n := utf8.RuneCountInString(p.Title); n < 1 || n > MaxChatTitleLength {
+		return fmt.Errorf("telegram: chat title is %d characters long, it must be 1-%d", n, MaxChatTitleLength)
+	}
+	return nil
+}
+
+// Validate checks the parameters of setChatDescription
+func (p *SetChatDescriptionParams) Validate() error {
+	if p.ChatID.IsZero() {
+		return fmt.Errorf("telegram: chat_id is required")
+	}
+	if n := utf8.RuneCountInString(p.Description); n > MaxChatDescriptionLength {
+		return fmt.Errorf("telegram: chat description is %d characters long, the limit is %d", n, MaxChatDescriptionLength)
+	}
+	return nil
+}
+
+// SetChatTitle calls setChatTitle. Setting the title the chat already has is not an error,
+// so it can be called to bring a chat to the wanted state without checking it first
+func SetChatTitle(ctx context.Context, client *http.Client, token string, params SetChatTitleParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setChatTitle", &params)
+	if IsChatNotModified(err) {
+		return nil
+	}
+	return err
+}
+
+// SetChatDescription calls setChatDescription. Like SetChatTitle, setting the description the chat
+// already has is not an error
+func SetChatDescription(ctx context.Context, client *http.Client, token string, params SetChatDescriptionParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setChatDescription", &params)
+	if IsChatNotModified(err) {
+		return nil
+	}
+	return err
+}
+
+// GetUserChatBoosts calls getUserChatBoosts, e.g. to reward the users that boost a channel.
+// The bot must be an administrator of the chat
+func GetUserChatBoosts(ctx context.Context, client *http.Client, token string, chatID ChatID, userID int64) (*UserChatBoosts, error) {
+	if chatID.IsZero() {
+		return nil, fmt.Errorf("telegram: chat_id is required")
+	}
+	if userID <= 0 {
+		return nil, fmt.Errorf("telegram: invalid user_id %d", userID)
+	}
+	boosts, err := Call[UserChatBoosts](ctx, client, token, "getUserChatBoosts", &GetUserChatBoostsParams{ChatID: chatID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return &boosts, nil
+}
+
+// GetLinkedChat calls getChat twice: once for chatID, and once for its linked chat (the discussion group
+// of a channel, or the channel of a discussion group). It returns an error if the chat has no linked chat
+func GetLinkedChat(ctx context.Context, client *http.Client, token string, chatID ChatID) (*ChatFullInfo, error) {
+	if chatID.IsZero() {
+		return nil, fmt.Errorf("telegram: chat_id is required")
+	}
+	chat, err := Call[ChatFullInfo](ctx, client, token, "getChat", &GetChatParams{ChatID: chatID})
+	if err != nil {
+		return nil, err
+	}
+	linked, ok := chat.LinkedChat()
+	if !ok {
+		return nil, fmt.Errorf("telegram: chat %s has no linked chat", chatID)
+	}
+	linkedChat, err := Call[ChatFullInfo](ctx, client, token, "getChat", &GetChatParams{ChatID: linked})
+	if err != nil {
+		return nil, err
+	}
+	return &linkedChat, nil
+}
@@ -0,0 +1,172 @@
+/* chat_test.go : tests for the chat helpers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestSetChatNotModified(t *testing.T) {
+	setTitle := func(client *http.Client) error {
+		return SetChatTitle(context.Background(), client, "123:abc", SetChatTitleParams{ChatID: NewChatID(-100), Title: "Group"})
+	}
+	setDescription := func(client *http.Client) error {
+		return SetChatDescription(context.Background(), client, "123:abc", SetChatDescriptionParams{ChatID: NewChatID(-100), Description: "About"})
+	}
+	tests := []struct {
+		name       string
+		call       func(client *http.Client) error
+		wantMethod string
+		answer     string
+		wantErr    bool
+	}{
+		{
+			name:       "title changed",
+			call:       setTitle,
+			wantMethod: "setChatTitle",
+			answer:     `{"ok":true,"result":true}`,
+		},
+		{
+			name:       "same title",
+			call:       setTitle,
+			wantMethod: "setChatTitle",
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: chat title is not modified"}`,
+		},
+		{
+			name:       "title without rights",
+			call:       setTitle,
+			wantMethod: "setChatTitle",
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: not enough rights to change chat title"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "description changed",
+			call:       setDescription,
+			wantMethod: "setChatDescription",
+			answer:     `{"ok":true,"result":true}`,
+		},
+		{
+			name:       "same description",
+			call:       setDescription,
+			wantMethod: "setChatDescription",
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: chat description is not modified"}`,
+		},
+		{
+			name:       "description in a missing chat",
+			call:       setDescription,
+			wantMethod: "setChatDescription",
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod string
+			client := fakeClient(func(method string, params []byte) string {
+				gotMethod = method
+				return tt.answer
+			})
+			err := tt.call(client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var apiErr *APIError
+			if tt.wantErr && !errors.As(err, &apiErr) {
+				t.Errorf("error %v is not an *APIError", err)
+			}
+			if gotMethod != tt.wantMethod {
+				t.Errorf("called %q, want %q", gotMethod, tt.wantMethod)
+			}
+		})
+	}
+}
+
+func TestDecodeChatReactions(t *testing.T) {
+	const data = `{"id":-1001234567890,"type":"supergroup","title":"Group","accent_color_id":5,"max_reaction_count":3,
+		"available_reactions":[{"type":"emoji","emoji":"👍"},{"type":"custom_emoji","custom_emoji_id":"5368324170671202286"},{"type":"paid"}],
+		"background_custom_emoji_id":"111","profile_accent_color_id":2,"profile_background_custom_emoji_id":"222"}`
+	var chat ChatFullInfo
+	if err := json.Unmarshal([]byte(data), &chat); err != nil {
+		t.Fatal(err)
+	}
+	if chat.AccentColorID != 5 || chat.MaxReactionCount != 3 || chat.BackgroundCustomEmojiID != "111" ||
+		chat.ProfileAccentColorID != 2 || chat.ProfileBackgroundCustomEmojiID != "222" {
+		t.Errorf("decoded %+v", chat)
+	}
+	r := chat.AvailableReactions
+	if len(r) != 3 || r[0].Emoji == nil || r[0].Emoji.Emoji != "👍" || r[1].CustomEmoji == nil ||
+		r[1].CustomEmoji.CustomEmojiID != "5368324170671202286" || r[2].Paid == nil {
+		t.Errorf("AvailableReactions = %+v", r)
+	}
+}
+
+func TestAllowsReaction(t *testing.T) {
+	thumbsUp := ReactionType{Emoji: &ReactionTypeEmoji{Emoji: "👍"}}
+	heart := ReactionType{Emoji: &ReactionTypeEmoji{Emoji: "❤"}}
+	custom := ReactionType{CustomEmoji: &ReactionTypeCustomEmoji{CustomEmojiID: "1"}}
+	otherCustom := ReactionType{CustomEmoji: &ReactionTypeCustomEmoji{CustomEmojiID: "2"}}
+	paid := ReactionType{Paid: &ReactionTypePaid{}}
+	tests := []struct {
+		name      string
+		available []ReactionType
+		reaction  ReactionType
+		want      bool
+	}{
+		{name: "no restriction, emoji", available: nil, reaction: thumbsUp, want: true},
+		{name: "no restriction, custom emoji", available: nil, reaction: custom, want: false},
+		{name: "nothing allowed", available: []ReactionType{}, reaction: thumbsUp, want: false},
+		{name: "listed emoji", available: []ReactionType{heart, thumbsUp}, reaction: thumbsUp, want: true},
+		{name: "unlisted emoji", available: []ReactionType{heart}, reaction: thumbsUp, want: false},
+		{name: "listed custom emoji", available: []ReactionType{custom}, reaction: custom, want: true},
+		{name: "unlisted custom emoji", available: []ReactionType{custom}, reaction: otherCustom, want: false},
+		{name: "paid", available: []ReactionType{thumbsUp, paid}, reaction: paid, want: true},
+		{name: "paid not listed", available: []ReactionType{thumbsUp}, reaction: paid, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chat := ChatFullInfo{AvailableReactions: tt.available}
+			if got := chat.AllowsReaction(tt.reaction); got != tt.want {
+				t.Errorf("AllowsReaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeChatSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want ChatFullInfo
+	}{
+		{
+			name: "all set",
+			json: `{"id":-1001234567890,"type":"supergroup","accent_color_id":0,"max_reaction_count":11,"join_to_send_messages":true,"join_by_request":true,"has_aggressive_anti_spam_enabled":true,"has_hidden_members":true,"has_protected_content":true,"has_visible_history":true}`,
+			want: ChatFullInfo{JoinToSendMessages: true, JoinByRequest: true, HasAggressiveAntiSpamEnabled: true, HasHiddenMembers: true, HasProtectedContent: true, HasVisibleHistory: true},
+		},
+		{
+			name: "omitted",
+			json: `{"id":-1001234567890,"type":"supergroup","accent_color_id":0,"max_reaction_count":11}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var chat ChatFullInfo
+			if err := json.Unmarshal([]byte(tt.json), &chat); err != nil {
+				t.Fatal(err)
+			}
+			if chat.JoinToSendMessages != tt.want.JoinToSendMessages || chat.JoinByRequest != tt.want.JoinByRequest ||
+				chat.HasAggressiveAntiSpamEnabled != tt.want.HasAggressiveAntiSpamEnabled || chat.HasHiddenMembers != tt.want.HasHiddenMembers ||
+				chat.HasProtectedContent != tt.want.HasProtectedContent || chat.HasVisibleHistory != tt.want.HasVisibleHistory {
+				t.Errorf("decoded %+v", chat)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/* chatcache.go : caching the information about chats
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Anti-spam bots look at the same chats over and over: how many members,
+ * who the administrators are. ChatCache answers getChat, getChatMemberCount
+ * and getChatAdministrators from the results of a short while ago. It is a
+ * separate type, so the plain functions always ask Telegram.
+ */
+
+package telegram
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// How long ChatCache keeps the results by default. Short, since a member count changes all the time
+const DefaultChatCacheTTL = 30 * time.Second
+
+// ChatCache calls getChat, getChatMemberCount and getChatAdministrators only for the chats whose results
+// it doesn't have or are older than its ttl. Pass it every update with HandleUpdate so that chat_member
+// updates invalidate what they change. The results are shared: don't modify them.
+// It is safe for concurrent use
+type ChatCache struct {
+	client *http.Client
+	token  string
+
+	chats  *ttlCache[string, *ChatFullInfo]
+	counts *ttlCache[string, int64]
+	admins *ttlCache[string, []ChatMember]
+}
+
+// NewChatCache returns a ChatCache that calls the Bot API with client and token,
+// and keeps the results for ttl (DefaultChatCacheTTL if ttl is 0)
+func NewChatCache(client *http.Client, token string, ttl time.Duration) *ChatCache {
+	return &ChatCache{
+		client: client,
+		token:  token,
+		chats:  newTTLCache[string, *ChatFullInfo](ttl, DefaultChatCacheTTL),
+		counts: newTTLCache[string, int64](ttl, DefaultChatCacheTTL),
+		admins: newTTLCache[string, []ChatMember](ttl, DefaultChatCacheTTL),
+	}
+}
+
+// GetChat is like the GetChat function, but uses the cache
+func (c *ChatCache) GetChat(ctx context.Context, chatID ChatID) (*ChatFullInfo, error) {
+	return cached(c.chats, chatID, func() (*ChatFullInfo, error) {
+		return GetChat(ctx, c.client, c.token, chatID)
+	})
+}
+
+// GetChatMemberCount is like the GetChatMemberCount function, but uses the cache
+func (c *ChatCache) GetChatMemberCount(ctx context.Context, chatID ChatID) (int64, error) {
+	return cached(c.counts, chatID, func() (int64, error) {
+		return GetChatMemberCount(ctx, c.client, c.token, chatID)
+	})
+}
+
+// GetChatAdministrators is like the GetChatAdministrators function, but uses the cache
+func (c *ChatCache) GetChatAdministrators(ctx context.Context, chatID ChatID) ([]ChatMember, error) {
+	return cached(c.admins, chatID, func() ([]ChatMember, error) {
+		return GetChatAdministrators(ctx, c.client, c.token, chatID)
+	})
+}
+
+// Returns the entry of chatID, or calls fetch and caches its result. Errors aren't cached
+func cached[V any](cache *ttlCache[string, V], chatID ChatID, fetch func() (V, error)) (V, error) {
+	key := chatID.String()
+	if v, ok := cache.get(key); ok {
+		return v, nil
+	}
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	cache.set(key, v)
+	return v, nil
+}
+
+// Invalidate forgets everything about the chat
+func (c *ChatCache) Invalidate(chatID ChatID) {
+	key := chatID.String()
+	c.chats.delete(key)
+	c.counts.delete(key)
+	c.admins.delete(key)
+}
+
+// HandleUpdate invalidates what a chat_member or my_chat_member update changes: the member count
+// when someone joins or leaves, the administrators when one is promoted or demoted.
+// A my_chat_member update invalidates the whole chat, since what the bot can see depends on its status.
+// Only the chat under its identifier is invalidated: a chat cached by username expires after the ttl.
+// Other updates are ignored
+func (c *ChatCache) HandleUpdate(u *Update) {
+	if u.MyChatMember != nil {
+		c.Invalidate(NewChatID(u.MyChatMember.Chat.ID))
+	}
+	if changed := u.ChatMember; changed != nil {
+		key := NewChatID(changed.Chat.ID).String()
+		if changed.WasAdded() || changed.WasRemoved() {
+			c.counts.delete(key)
+		}
+		if changed.OldChatMember.IsAdmin() || changed.NewChatMember.IsAdmin() {
+			c.admins.delete(key)
+		}
+	}
+}
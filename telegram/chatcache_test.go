@@ -0,0 +1,156 @@
+/* chatcache_test.go : tests for the cache of chat information
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// A ChatCache over a fake Bot API that counts the calls of each method, with a clock the test moves
+func newTestChatCache(ttl time.Duration) (cache *ChatCache, calls map[string]int, now *time.Time) {
+	calls = make(map[string]int)
+	client := fakeClient(func(method string, params []byte) string {
+		calls[method]++
+		switch method {
+		case "getChat":
+			return `{"ok":true,"result":{"id":-1001234567890,"type":"supergroup","title":"Group","accent_color_id":0,"max_reaction_count":11}}`
+		case "getChatMemberCount":
+			return `{"ok":true,"result":57}`
+		case "getChatAdministrators":
+			return `{"ok":true,"result":[{"status":"creator","user":{"id":1,"is_bot":false,"first_name":"Owner"},"is_anonymous":false}]}`
+		}
+		return `{"ok":false,"error_code":404,"description":"Not Found"}`
+	})
+	cache = NewChatCache(client, "123:abc", ttl)
+	now = new(time.Time)
+	*now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return *now }
+	cache.chats.now, cache.counts.now, cache.admins.now = clock, clock, clock
+	return cache, calls, now
+}
+
+// getAll asks the cache for everything it knows about the chat
+func getAll(t *testing.T, cache *ChatCache, chatID ChatID) {
+	t.Helper()
+	ctx := context.Background()
+	if chat, err := cache.GetChat(ctx, chatID); err != nil || chat.Title != "Group" {
+		t.Fatalf("GetChat() = %+v, %v", chat, err)
+	}
+	if n, err := cache.GetChatMemberCount(ctx, chatID); err != nil || n != 57 {
+		t.Fatalf("GetChatMemberCount() = %d, %v", n, err)
+	}
+	if admins, err := cache.GetChatAdministrators(ctx, chatID); err != nil || len(admins) != 1 || admins[0].Owner == nil {
+		t.Fatalf("GetChatAdministrators() = %+v, %v", admins, err)
+	}
+}
+
+func TestChatCacheTTL(t *testing.T) {
+	chat := NewChatID(-1001234567890)
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		after     time.Duration
+		wantCalls int
+	}{
+		{name: "fresh", ttl: time.Minute, after: 59 * time.Second, wantCalls: 1},
+		{name: "expired", ttl: time.Minute, after: time.Minute, wantCalls: 2},
+		{name: "default ttl, fresh", after: DefaultChatCacheTTL - time.Second, wantCalls: 1},
+		{name: "default ttl, expired", after: DefaultChatCacheTTL, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache, calls, now := newTestChatCache(tt.ttl)
+			getAll(t, cache, chat)
+			*now = now.Add(tt.after)
+			getAll(t, cache, chat)
+			for _, method := range []string{"getChat", "getChatMemberCount", "getChatAdministrators"} {
+				if calls[method] != tt.wantCalls {
+					t.Errorf("%d calls of %s, want %d", calls[method], method, tt.wantCalls)
+				}
+			}
+		})
+	}
+}
+
+func TestChatCacheHandleUpdate(t *testing.T) {
+	const chatID = -1001234567890
+	admin := ChatMember{Administrator: &ChatMemberAdministrator{Status: "administrator", User: User{ID: 5}}}
+	member := ChatMember{Member: &ChatMemberMember{Status: "member", User: User{ID: 5}}}
+	left := ChatMember{Left: &ChatMemberLeft{Status: "left", User: User{ID: 5}}}
+	tests := []struct {
+		name   string
+		update Update
+		want   map[string]int // calls after the update
+	}{
+		{
+			name:   "join",
+			update: Update{ChatMember: &ChatMemberUpdated{Chat: Chat{ID: chatID}, OldChatMember: left, NewChatMember: member}},
+			want:   map[string]int{"getChat": 1, "getChatMemberCount": 2, "getChatAdministrators": 1},
+		},
+		{
+			name:   "promotion",
+			update: Update{ChatMember: &ChatMemberUpdated{Chat: Chat{ID: chatID}, OldChatMember: member, NewChatMember: admin}},
+			want:   map[string]int{"getChat": 1, "getChatMemberCount": 1, "getChatAdministrators": 2},
+		},
+		{
+			name:   "an administrator leaves",
+			update: Update{ChatMember: &ChatMemberUpdated{Chat: Chat{ID: chatID}, OldChatMember: admin, NewChatMember: left}},
+			want:   map[string]int{"getChat": 1, "getChatMemberCount": 2, "getChatAdministrators": 2},
+		},
+		{
+			name:   "the bot's status changes",
+			update: Update{MyChatMember: &ChatMemberUpdated{Chat: Chat{ID: chatID}, OldChatMember: member, NewChatMember: admin}},
+			want:   map[string]int{"getChat": 2, "getChatMemberCount": 2, "getChatAdministrators": 2},
+		},
+		{
+			name:   "another chat",
+			update: Update{ChatMember: &ChatMemberUpdated{Chat: Chat{ID: chatID - 1}, OldChatMember: member, NewChatMember: admin}},
+			want:   map[string]int{"getChat": 1, "getChatMemberCount": 1, "getChatAdministrators": 1},
+		},
+		{
+			name:   "a message",
+			update: Update{Message: &Message{Chat: Chat{ID: chatID}}},
+			want:   map[string]int{"getChat": 1, "getChatMemberCount": 1, "getChatAdministrators": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache, calls, _ := newTestChatCache(0)
+			getAll(t, cache, NewChatID(chatID))
+			cache.HandleUpdate(&tt.update)
+			getAll(t, cache, NewChatID(chatID))
+			for method, want := range tt.want {
+				if calls[method] != want {
+					t.Errorf("%d calls of %s, want %d", calls[method], method, want)
+				}
+			}
+		})
+	}
+}
+
+func TestChatCacheErrors(t *testing.T) {
+	cache, calls, _ := newTestChatCache(0)
+	for range 2 {
+		if _, err := cache.GetChat(context.Background(), NewChatID(0)); err == nil {
+			t.Fatal("GetChat() without a chat succeeded")
+		}
+	}
+	cache.client = fakeClient(func(method string, params []byte) string {
+		calls[method]++
+		return `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`
+	})
+	for range 2 {
+		if _, err := cache.GetChatMemberCount(context.Background(), NewChatID(-100)); !IsChatNotFound(err) {
+			t.Fatalf("GetChatMemberCount() error = %v", err)
+		}
+	}
+	if calls["getChatMemberCount"] != 2 {
+		t.Errorf("%d calls, want 2: errors aren't cached", calls["getChatMemberCount"])
+	}
+}
@@ -0,0 +1,122 @@
+/* chatid.go : the chat_id parameter
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Most methods take a chat_id that is "Integer or String": either the numeric
+ * identifier of the chat or the username of a channel/supergroup in the format
+ * @channelusername. Here is the "union" for it.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Identifier of a target chat: a numeric ID or a public username.
+// If Username is set, it wins over ID. The zero value means "no chat" (and is omitted
+// by the fields tagged omitzero)
+type ChatID struct {
+	// Numeric identifier of the chat. Groups and channels have negative identifiers,
+	// supergroups and channels start with -100
+	ID int64
+
+	// Public username of the chat, without the leading @
+	Username string
+}
+
+// NewChatID returns the ChatID of a chat known by its numeric identifier
+func NewChatID(id int64) ChatID {
+	return ChatID{ID: id}
+}
+
+// NewChatUsername returns the ChatID of a public chat known by its username.
+// The leading @ is optional
+func NewChatUsername(username string) ChatID {
+	return ChatID{Username: strings.TrimPrefix(username, "@")}
+}
+
+// ParseChatID parses a chat identifier as it is usually written in a configuration
+// file or an environment variable: "-1001234567890", "@channel" or "channel"
+func ParseChatID(s string) (ChatID, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ChatID{}, fmt.Errorf("telegram: empty chat id")
+	}
+
+	if s[0] == '-' || (s[0] >= '0' && s[0] <= '9') {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || id == 0 {
+			return ChatID{}, fmt.Errorf("telegram: invalid numeric chat id %q", s)
+		}
+		return NewChatID(id), nil
+	}
+
+	username := strings.TrimPrefix(s, "@")
+	if !isValidUsername(username) {
+		return ChatID{}, fmt.Errorf("telegram: invalid chat username %q", s)
+	}
+	return NewChatUsername(username), nil
+}
+
+// IsZero reports whether the ChatID is empty
+func (c ChatID) IsZero() bool {
+	return c.ID == 0 && c.Username == ""
+}
+
+// String returns the chat id the way Telegram writes it: the number, or the username with the @
+func (c ChatID) String() string {
+	if c.Username != "" {
+		return "@" + c.Username
+	}
+	return strconv.FormatInt(c.ID, 10)
+}
+
+// MarshalJSON encodes the ChatID as a JSON number or as an "@username" string
+func (c ChatID) MarshalJSON() ([]byte, error) {
+	if c.Username != "" {
+		return json.Marshal("@" + c.Username)
+	}
+	return json.Marshal(c.ID)
+}
+
+// UnmarshalJSON accepts both a JSON number and a string
+func (c *ChatID) UnmarshalJSON(data []byte) error {
+	var id int64
+	if err := json.Unmarshal(data, &id); err == nil {
+		*c = NewChatID(id)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("telegram: chat id must be a number or a string: %w", err)
+	}
+	parsed, err := ParseChatID(s)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// Telegram usernames are 5-32 characters long, made of latin letters, digits and underscores,
+// and start with a letter
+func isValidUsername(username string) bool {
+	if len(username) < 5 || len(username) > 32 {
+		return false
+	}
+	for i, r := range username {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '_'):
+		default:
+			return false
+		}
+	}
+	return true
+}
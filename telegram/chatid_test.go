@@ -0,0 +1,65 @@
+/* chatid_test.go : tests for chat identifiers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseChatID(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ChatID
+		wantErr bool
+	}{
+		{"-1001234567890", NewChatID(-1001234567890), false},
+		{"-123456", NewChatID(-123456), false},
+		{"42", NewChatID(42), false},
+		{" 42 ", NewChatID(42), false},
+		{"@channel", NewChatUsername("channel"), false},
+		{"channel", NewChatUsername("channel"), false},
+		{"@my_channel1", NewChatUsername("my_channel1"), false},
+		{"", ChatID{}, true},
+		{"0", ChatID{}, true},
+		{"-100abc", ChatID{}, true},
+		{"@abc", ChatID{}, true},      // too short
+		{"@1channel", ChatID{}, true}, // starts with a digit
+		{"@chan-nel", ChatID{}, true}, // invalid character
+		{"@", ChatID{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseChatID(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseChatID(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseChatID(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestChatIDJSON(t *testing.T) {
+	tests := []struct {
+		id   ChatID
+		json string
+	}{
+		{NewChatID(-1001234567890), `-1001234567890`},
+		{NewChatUsername("@channel"), `"@channel"`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.id)
+		if err != nil || string(data) != tt.json {
+			t.Errorf("Marshal(%+v) = %s, %v, want %s", tt.id, data, err, tt.json)
+		}
+		var back ChatID
+		if err := json.Unmarshal(data, &back); err != nil || back != tt.id {
+			t.Errorf("Unmarshal(%s) = %+v, %v", data, back, err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+/* checklist.go : sending checklists, and client-side checks on them
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"unicode/utf8"
+)
+
+// Limits documented for sendChecklist. Lengths are "characters after entities parsing":
+// we can only check the raw text, so a title with markup may still be rejected by the server
+const (
+	MaxChecklistTasks          = 30
+	MaxChecklistTitleLength    = 255
+	MaxChecklistTaskTextLength = 100
+)
+
+// Validate checks the checklist against the limits documented by Telegram,
+// so that the error is caught before the request instead of coming back as a 400
+func (c *InputChecklist) Validate() error {
+	if n := utf8.RuneCountInString(c.Title); n < 1 || n > MaxChecklistTitleLength {
+		return fmt.Errorf("telegram: checklist title must be 1-%d characters, got %d", MaxChecklistTitleLength, n)
+	}
+	if err := c.ParseMode.Validate(); err != nil {
+		return err
+	}
+	if c.ParseMode != ParseModeNone && len(c.TitleEntities) > 0 {
+		return fmt.Errorf("telegram: checklist title can't have both parse_mode and title_entities")
+	}
+	if n := len(c.Tasks); n < 1 || n > MaxChecklistTasks {
+		return fmt.Errorf("telegram: checklist must have 1-%d tasks, got %d", MaxChecklistTasks, n)
+	}
+
+	seen := make(map[int64]bool, len(c.Tasks))
+	for i, task := range c.Tasks {
+		if task.ID <= 0 {
+			return fmt.Errorf("telegram: checklist task %d: id must be positive, got %d", i, task.ID)
+		}
+		if seen[task.ID] {
+			return fmt.Errorf("telegram: checklist task %d: duplicate id %d", i, task.ID)
+		}
+		seen[task.ID] = true
+
+		if n := utf8.RuneCountInString(task.Text); n < 1 || n > MaxChecklistTaskTextLength {
+			return fmt.Errorf("telegram: checklist task %d: text must be 1-%d characters, got %d", i, MaxChecklistTaskTextLength, n)
+		}
+		if task.ParseMode.check() != nil {
+			return fmt.Errorf("telegram: checklist task %d: %w", i, task.ParseMode.check())
+		}
+		if task.ParseMode != ParseModeNone && len(task.TextEntities) > 0 {
+			return fmt.Errorf("telegram: checklist task %d: can't have both parse_mode and text_entities", i)
+		}
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendChecklist
+func (p *SendChecklistParams) Validate() error {
+	if err := validateBusinessConnection(p.BusinessConnectionID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.ChatID == 0 {
+		return fmt.Errorf("telegram: chat_id is required")
+	}
+	return p.Checklist.Validate()
+}
+
+// Validate checks the parameters of editMessageChecklist
+func (p *EditMessageChecklistParams) Validate() error {
+	if err := validateBusinessConnection(p.BusinessConnectionID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.ChatID == 0 {
+		return fmt.Errorf("telegram: chat_id is required")
+	}
+	if p.MessageID <= 0 {
+		return fmt.Errorf("telegram: invalid message_id %d", p.MessageID)
+	}
+	return p.Checklist.Validate()
+}
+
+// SendChecklist calls sendChecklist. Checklists can only be sent on behalf of a business account,
+// so BusinessConnectionID is required
+func SendChecklist(ctx context.Context, client *http.Client, token string, params SendChecklistParams) (*Message, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	m, err := Call[Message](ctx, client, token, "sendChecklist", &params)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// EditMessageChecklist calls editMessageChecklist, which replaces the checklist of a message
+// sent with SendChecklist on behalf of the same business account
+func EditMessageChecklist(ctx context.Context, client *http.Client, token string, params EditMessageChecklistParams) (*Message, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	m, err := Call[Message](ctx, client, token, "editMessageChecklist", &params)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
@@ -0,0 +1,81 @@
+/* checklist_test.go : tests for sending checklists
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestChecklistValidate(t *testing.T) {
+	checklist := InputChecklist{Title: "Groceries", Tasks: []InputChecklistTask{{ID: 1, Text: "Milk"}, {ID: 2, Text: "Eggs"}}}
+	tests := []struct {
+		name    string
+		params  interface{ Validate() error }
+		wantErr bool
+	}{
+		{name: "send", params: &SendChecklistParams{BusinessConnectionID: "bc", ChatID: 42, Checklist: checklist}},
+		{name: "send without business connection", params: &SendChecklistParams{ChatID: 42, Checklist: checklist}, wantErr: true},
+		{name: "send without chat", params: &SendChecklistParams{BusinessConnectionID: "bc", Checklist: checklist}, wantErr: true},
+		{name: "send without tasks", params: &SendChecklistParams{BusinessConnectionID: "bc", ChatID: 42, Checklist: InputChecklist{Title: "Empty"}}, wantErr: true},
+		{name: "edit", params: &EditMessageChecklistParams{BusinessConnectionID: "bc", ChatID: 42, MessageID: 7, Checklist: checklist}},
+		{name: "edit without message", params: &EditMessageChecklistParams{BusinessConnectionID: "bc", ChatID: 42, Checklist: checklist}, wantErr: true},
+		{name: "edit without business connection", params: &EditMessageChecklistParams{ChatID: 42, MessageID: 7, Checklist: checklist}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSendChecklist(t *testing.T) {
+	const answer = `{"ok":true,"result":{"message_id":7,"date":1700000000,"chat":{"id":42,"type":"private"},` +
+		`"checklist":{"title":"Groceries","tasks":[{"id":1,"text":"Milk"},{"id":2,"text":"Eggs"}]}}}`
+	var methods []string
+	var sent map[string]any
+	client := fakeClient(func(method string, params []byte) string {
+		methods = append(methods, method)
+		json.Unmarshal(params, &sent)
+		return answer
+	})
+	checklist := InputChecklist{Title: "Groceries", Tasks: []InputChecklistTask{{ID: 1, Text: "Milk"}, {ID: 2, Text: "Eggs"}}}
+
+	m, err := SendChecklist(context.Background(), client, "123:abc", SendChecklistParams{BusinessConnectionID: "bc", ChatID: 42, Checklist: checklist})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.MessageID != 7 || m.Checklist == nil || len(m.Checklist.Tasks) != 2 {
+		t.Errorf("SendChecklist() = %+v", m)
+	}
+	if sent["business_connection_id"] != "bc" || sent["chat_id"] != 42.0 {
+		t.Errorf("sent %v", sent)
+	}
+
+	checklist.Tasks = append(checklist.Tasks, InputChecklistTask{ID: 3, Text: "Bread"})
+	if _, err := EditMessageChecklist(context.Background(), client, "123:abc", EditMessageChecklistParams{BusinessConnectionID: "bc", ChatID: 42, MessageID: 7, Checklist: checklist}); err != nil {
+		t.Fatal(err)
+	}
+	if tasks, _ := sent["checklist"].(map[string]any)["tasks"].([]any); len(tasks) != 3 || sent["message_id"] != 7.0 {
+		t.Errorf("sent %v", sent)
+	}
+	if got := strings.Join(methods, ","); got != "sendChecklist,editMessageChecklist" {
+		t.Errorf("methods = %s", got)
+	}
+
+	// Invalid parameters don't reach Telegram
+	if _, err := SendChecklist(context.Background(), client, "123:abc", SendChecklistParams{ChatID: 42, Checklist: checklist}); err == nil {
+		t.Error("SendChecklist() without business connection succeeded")
+	}
+	if len(methods) != 2 {
+		t.Errorf("%d calls, want 2", len(methods))
+	}
+}
@@ -0,0 +1,131 @@
+/* commands.go : the list of the bot's commands
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * The commands shown in the menu of the clients are set per scope and per
+ * language: a user sees the list of the narrowest scope that has one, in
+ * their language if there is a list for it, or the one without language.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits of setMyCommands
+const (
+	MaxBotCommands                 = 100
+	MaxBotCommandLength            = 32
+	MaxBotCommandDescriptionLength = 256
+)
+
+// Validate checks the name and the description of the command
+func (c *BotCommand) Validate() error {
+	if c.Command == "" || len(c.Command) > MaxBotCommandLength {
+		return fmt.Errorf("telegram: command %q must be 1-%d characters long", c.Command, MaxBotCommandLength)
+	}
+	for _, r := range c.Command {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+		default:
+			return fmt.Errorf("telegram: command %q can't contain %q", c.Command, r)
+		}
+	}
+	if n := utf8.RuneCountInString(c.Description); n < 1 || n > MaxBotCommandDescriptionLength {
+		return fmt.Errorf("telegram: description of command %q is %d characters long, it must be 1-%d", c.Command, n, MaxBotCommandDescriptionLength)
+	}
+	return nil
+}
+
+// Validate checks the number of commands, each of them, and that no command appears twice
+func (p *SetMyCommandsParams) Validate() error {
+	if len(p.Commands) > MaxBotCommands {
+		return fmt.Errorf("telegram: %d commands, the limit is %d", len(p.Commands), MaxBotCommands)
+	}
+	seen := make(map[string]bool, len(p.Commands))
+	for i := range p.Commands {
+		if err := p.Commands[i].Validate(); err != nil {
+			return err
+		}
+		if seen[p.Commands[i].Command] {
+			return fmt.Errorf("telegram: command %q appears twice", p.Commands[i].Command)
+		}
+		seen[p.Commands[i].Command] = true
+	}
+	if len(p.LanguageCode) != 0 && len(p.LanguageCode) != 2 {
+		return fmt.Errorf("telegram: language code %q is not a two-letter ISO 639-1 code", p.LanguageCode)
+	}
+	return nil
+}
+
+// SetMyCommands calls setMyCommands
+func SetMyCommands(ctx context.Context, client *http.Client, token string, params SetMyCommandsParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if params.Commands == nil {
+		params.Commands = []BotCommand{} // an empty list removes the commands, null is refused
+	}
+	_, err := callBool(ctx, client, token, "setMyCommands", &params)
+	return err
+}
+
+// GetMyCommands calls getMyCommands. An empty list means there are no commands for exactly that
+// scope and language: Telegram doesn't fall back to the others, see EffectiveCommands
+func GetMyCommands(ctx context.Context, client *http.Client, token string, params GetMyCommandsParams) ([]BotCommand, error) {
+	return Call[[]BotCommand](ctx, client, token, "getMyCommands", &params)
+}
+
+// SetMyCommandsForLanguages sets the commands of scope for several languages at once. The key of
+// commands is the language code, "" for the list shown to users whose language has no list of its own.
+// The default list is set first, then the others in alphabetical order; the first error stops
+// the sequence and tells which language failed. Every list is validated before sending anything
+func SetMyCommandsForLanguages(ctx context.Context, client *http.Client, token string, commands map[string][]BotCommand, scope *BotCommandScope) error {
+	languages := slices.Sorted(maps.Keys(commands)) // "" sorts first
+	for _, lang := range languages {
+		params := SetMyCommandsParams{Commands: commands[lang], Scope: scope, LanguageCode: lang}
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("%w (language %q)", err, lang)
+		}
+	}
+	for _, lang := range languages {
+		params := SetMyCommandsParams{Commands: commands[lang], Scope: scope, LanguageCode: lang}
+		if err := SetMyCommands(ctx, client, token, params); err != nil {
+			return fmt.Errorf("telegram: setting the commands for language %q: %w", lang, err)
+		}
+	}
+	return nil
+}
+
+// ResolveCommands returns the list of commands a user with languageCode sees, among the lists passed
+// to SetMyCommandsForLanguages: the one of the language (the user's language_code may be a longer tag
+// like "pt-br", of which only "pt" counts), otherwise the default one
+func ResolveCommands(commands map[string][]BotCommand, languageCode string) []BotCommand {
+	lang, _, _ := strings.Cut(strings.ToLower(languageCode), "-")
+	if list, ok := commands[lang]; ok && lang != "" {
+		return list
+	}
+	return commands[""]
+}
+
+// EffectiveCommands asks Telegram for the commands of scope that a user with languageCode sees:
+// the list of the language if it isn't empty, otherwise the default one. Only the given scope is
+// looked at, not the wider ones
+func EffectiveCommands(ctx context.Context, client *http.Client, token string, scope *BotCommandScope, languageCode string) ([]BotCommand, error) {
+	lang, _, _ := strings.Cut(strings.ToLower(languageCode), "-")
+	if lang != "" {
+		list, err := GetMyCommands(ctx, client, token, GetMyCommandsParams{Scope: scope, LanguageCode: lang})
+		if err != nil || len(list) > 0 {
+			return list, err
+		}
+	}
+	return GetMyCommands(ctx, client, token, GetMyCommandsParams{Scope: scope})
+}
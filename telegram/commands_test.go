@@ -0,0 +1,201 @@
+/* commands_test.go : tests for the list of the bot's commands
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var (
+	englishCommands = []BotCommand{{Command: "start", Description: "Start the bot"}}
+	italianCommands = []BotCommand{{Command: "start", Description: "Avvia il bot"}}
+	germanCommands  = []BotCommand{{Command: "start", Description: "Bot starten"}}
+)
+
+func TestBotCommandValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		command BotCommand
+		wantErr bool
+	}{
+		{name: "valid", command: BotCommand{Command: "set_lang2", Description: "Change the language"}},
+		{name: "longest name", command: BotCommand{Command: strings.Repeat("a", MaxBotCommandLength), Description: "d"}},
+		{name: "name too long", command: BotCommand{Command: strings.Repeat("a", MaxBotCommandLength+1), Description: "d"}, wantErr: true},
+		{name: "upper case", command: BotCommand{Command: "Start", Description: "d"}, wantErr: true},
+		{name: "leading slash", command: BotCommand{Command: "/start", Description: "d"}, wantErr: true},
+		{name: "no description", command: BotCommand{Command: "start"}, wantErr: true},
+		{name: "description counted in characters", command: BotCommand{Command: "start", Description: strings.Repeat("é", MaxBotCommandDescriptionLength)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.command.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetMyCommandsForLanguages(t *testing.T) {
+	commands := map[string][]BotCommand{"it": italianCommands, "": englishCommands, "de": germanCommands}
+	scope := &BotCommandScope{AllPrivateChats: &BotCommandScopeAllPrivateChats{}}
+	tests := []struct {
+		name      string
+		commands  map[string][]BotCommand
+		failOn    string
+		wantLangs []string
+		wantErr   string
+	}{
+		{name: "default first, then alphabetical", commands: commands, wantLangs: []string{"", "de", "it"}},
+		{
+			name:      "stops at the first failure",
+			commands:  commands,
+			failOn:    "de",
+			wantLangs: []string{"", "de"},
+			wantErr:   `language "de"`,
+		},
+		{
+			name:     "validates every list before sending",
+			commands: map[string][]BotCommand{"": englishCommands, "it": {{Command: "Avvia", Description: "d"}}},
+			wantErr:  `language "it"`,
+		},
+		{
+			name:     "bad language code",
+			commands: map[string][]BotCommand{"": englishCommands, "ita": italianCommands},
+			wantErr:  `language "ita"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var langs []string
+			client := fakeClient(func(method string, params []byte) string {
+				var sent struct {
+					Commands     []BotCommand    `json:"commands"`
+					Scope        json.RawMessage `json:"scope"`
+					LanguageCode string          `json:"language_code"`
+				}
+				if err := json.Unmarshal(params, &sent); err != nil {
+					t.Error(err)
+				}
+				if method != "setMyCommands" || string(sent.Scope) != `{"type":"all_private_chats"}` {
+					t.Errorf("called %s with scope %s", method, sent.Scope)
+				}
+				if !slices.Equal(sent.Commands, tt.commands[sent.LanguageCode]) {
+					t.Errorf("commands for %q = %v, want %v", sent.LanguageCode, sent.Commands, tt.commands[sent.LanguageCode])
+				}
+				langs = append(langs, sent.LanguageCode)
+				if tt.failOn != "" && sent.LanguageCode == tt.failOn {
+					return `{"ok":false,"error_code":400,"description":"Bad Request: BOT_COMMAND_DESCRIPTION_INVALID"}`
+				}
+				return `{"ok":true,"result":true}`
+			})
+
+			err := SetMyCommandsForLanguages(context.Background(), client, "123:abc", tt.commands, scope)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("SetMyCommandsForLanguages() = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("SetMyCommandsForLanguages() = %v, want an error about %s", err, tt.wantErr)
+			}
+			if !slices.Equal(langs, tt.wantLangs) {
+				t.Errorf("set the languages %q, want %q", langs, tt.wantLangs)
+			}
+		})
+	}
+
+	t.Run("the API error is kept", func(t *testing.T) {
+		client := fakeClient(func(method string, params []byte) string {
+			return `{"ok":false,"error_code":400,"description":"Bad Request: BOT_COMMAND_DESCRIPTION_INVALID"}`
+		})
+		err := SetMyCommandsForLanguages(context.Background(), client, "123:abc", commands, nil)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.ErrorCode != 400 {
+			t.Errorf("SetMyCommandsForLanguages() = %v, want the APIError", err)
+		}
+	})
+}
+
+func TestResolveCommands(t *testing.T) {
+	commands := map[string][]BotCommand{"": englishCommands, "it": italianCommands}
+	tests := []struct {
+		name         string
+		commands     map[string][]BotCommand
+		languageCode string
+		want         []BotCommand
+	}{
+		{name: "own language", commands: commands, languageCode: "it", want: italianCommands},
+		{name: "longer tag", commands: commands, languageCode: "it-CH", want: italianCommands},
+		{name: "upper case", commands: commands, languageCode: "IT", want: italianCommands},
+		{name: "other language", commands: commands, languageCode: "de", want: englishCommands},
+		{name: "no language", commands: commands, languageCode: "", want: englishCommands},
+		{name: "no default", commands: map[string][]BotCommand{"it": italianCommands}, languageCode: "de", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveCommands(tt.commands, tt.languageCode); !slices.Equal(got, tt.want) {
+				t.Errorf("ResolveCommands(%q) = %v, want %v", tt.languageCode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveCommands(t *testing.T) {
+	// What getMyCommands answers for each language: there is no list for "de"
+	lists := map[string]string{
+		"":   `[{"command":"start","description":"Start the bot"}]`,
+		"it": `[{"command":"start","description":"Avvia il bot"}]`,
+		"de": `[]`,
+	}
+	tests := []struct {
+		name         string
+		languageCode string
+		wantAsked    []string
+		want         []BotCommand
+	}{
+		{name: "own language", languageCode: "it-IT", wantAsked: []string{"it"}, want: italianCommands},
+		{name: "empty list falls back", languageCode: "de", wantAsked: []string{"de", ""}, want: englishCommands},
+		{name: "no language", languageCode: "", wantAsked: []string{""}, want: englishCommands},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var asked []string
+			client := fakeClient(func(method string, params []byte) string {
+				var sent GetMyCommandsParams
+				if err := json.Unmarshal(params, &sent); err != nil {
+					t.Error(err)
+				}
+				asked = append(asked, sent.LanguageCode)
+				return `{"ok":true,"result":` + lists[sent.LanguageCode] + `}`
+			})
+			got, err := EffectiveCommands(context.Background(), client, "123:abc", nil, tt.languageCode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("EffectiveCommands(%q) = %v, want %v", tt.languageCode, got, tt.want)
+			}
+			if !slices.Equal(asked, tt.wantAsked) {
+				t.Errorf("asked for the languages %q, want %q", asked, tt.wantAsked)
+			}
+		})
+	}
+
+	t.Run("errors don't fall back", func(t *testing.T) {
+		calls := 0
+		client := fakeClient(func(method string, params []byte) string {
+			calls++
+			return `{"ok":false,"error_code":401,"description":"Unauthorized"}`
+		})
+		if _, err := EffectiveCommands(context.Background(), client, "123:abc", nil, "it"); err == nil || calls != 1 {
+			t.Errorf("EffectiveCommands() = %v after %d calls, want an error after 1", err, calls)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+/* context.go : values carried by a context.Context
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * The context given to the code handling an update is request-scoped: it lives
+ * as long as the handling of that update and it is cancelled when the handler
+ * should stop. It is also the place for the update itself, so that functions
+ * deep in the call chain don't need to take it as a parameter.
+ */
+
+package telegram
+
+import "context"
+
+// Unexported type for the keys, so that no other package can collide with them
+type contextKey int
+
+const updateContextKey contextKey = iota
+
+// ContextWithUpdate returns a copy of ctx that carries the update
+func ContextWithUpdate(ctx context.Context, u *Update) context.Context {
+	return context.WithValue(ctx, updateContextKey, u)
+}
+
+// UpdateFromContext returns the update carried by ctx, or nil if there is none
+func UpdateFromContext(ctx context.Context) *Update {
+	u, _ := ctx.Value(updateContextKey).(*Update)
+	return u
+}
+
+// EffectiveChatFromContext returns the effective chat of the update carried by ctx, or nil
+func EffectiveChatFromContext(ctx context.Context) *Chat {
+	if u := UpdateFromContext(ctx); u != nil {
+		return u.EffectiveChat()
+	}
+	return nil
+}
+
+// EffectiveUserFromContext returns the effective user of the update carried by ctx, or nil
+func EffectiveUserFromContext(ctx context.Context) *User {
+	if u := UpdateFromContext(ctx); u != nil {
+		return u.EffectiveUser()
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+/* context_test.go : tests for the values carried by a context.Context
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateContext(t *testing.T) {
+	var u Update
+	data := `{"update_id":1,"message":{"message_id":7,"date":1700000000,"chat":{"id":-100,"type":"supergroup","title":"Group"},"from":{"id":42,"is_bot":false,"first_name":"Ann"},"text":"hi"}}`
+	if err := json.Unmarshal([]byte(data), &u); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = ContextWithUpdate(ctx, &u)
+	if got := UpdateFromContext(ctx); got != &u {
+		t.Errorf("UpdateFromContext() = %p, want %p", got, &u)
+	}
+	if chat := EffectiveChatFromContext(ctx); chat == nil || chat.ID != -100 {
+		t.Errorf("EffectiveChatFromContext() = %+v", chat)
+	}
+	if user := EffectiveUserFromContext(ctx); user == nil || user.ID != 42 {
+		t.Errorf("EffectiveUserFromContext() = %+v", user)
+	}
+
+	// The update stays in the context, and the context is still cancelled with its parent
+	cancel()
+	if ctx.Err() == nil || UpdateFromContext(ctx) != &u {
+		t.Errorf("after cancel: err %v, update %p", ctx.Err(), UpdateFromContext(ctx))
+	}
+}
+
+func TestUpdateContextEmpty(t *testing.T) {
+	ctx := context.Background()
+	if u := UpdateFromContext(ctx); u != nil {
+		t.Errorf("UpdateFromContext() = %+v, want nil", u)
+	}
+	if chat := EffectiveChatFromContext(ctx); chat != nil {
+		t.Errorf("EffectiveChatFromContext() = %+v, want nil", chat)
+	}
+	if user := EffectiveUserFromContext(ctx); user != nil {
+		t.Errorf("EffectiveUserFromContext() = %+v, want nil", user)
+	}
+
+	// An update without a chat, like an inline query, has only a user
+	ctx = ContextWithUpdate(ctx, &Update{InlineQuery: &InlineQuery{ID: "q", From: User{ID: 42}}})
+	if chat := EffectiveChatFromContext(ctx); chat != nil {
+		t.Errorf("EffectiveChatFromContext() = %+v for an inline query, want nil", chat)
+	}
+	if user := EffectiveUserFromContext(ctx); user == nil || user.ID != 42 {
+		t.Errorf("EffectiveUserFromContext() = %+v", user)
+	}
+}
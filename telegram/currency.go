@@ -0,0 +1,101 @@
+/* currency.go : currencies and amounts of payments
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Amounts are integers in the smallest unit of the currency: 9.99 USD is 999,
+ * but 999 JPY is 999, because the yen has no cents. Getting the exponent wrong
+ * charges 100 times too much (or too little), and computing amounts with
+ * floats rounds 0.29*100 to 28. Amount works on the decimal string instead.
+ */
+
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A three-letter ISO 4217 currency code accepted by Telegram, or CurrencyStars for payments in Telegram Stars
+type Currency string
+
+// Payments in Telegram Stars, which have no fractions
+const CurrencyStars Currency = "XTR"
+
+// Number of digits after the decimal point of each supported currency, as in ISO 4217
+// (and in the currencies.json that Telegram publishes, which is the reference if they ever differ)
+var currencyExponents = map[Currency]int{
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ARS": 2, "AUD": 2, "AZN": 2, "BAM": 2, "BDT": 2, "BGN": 2,
+	"BND": 2, "BOB": 2, "BRL": 2, "BYN": 2, "CAD": 2, "CHF": 2, "CLP": 0, "CNY": 2, "COP": 2, "CRC": 2,
+	"CZK": 2, "DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ETB": 2, "EUR": 2, "GBP": 2, "GEL": 2, "GTQ": 2,
+	"HKD": 2, "HNL": 2, "HRK": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "ISK": 0, "JMD": 2, "JPY": 0,
+	"KES": 2, "KGS": 2, "KRW": 0, "KZT": 2, "LBP": 2, "LKR": 2, "MAD": 2, "MDL": 2, "MNT": 2, "MUR": 2,
+	"MXN": 2, "MYR": 2, "MZN": 2, "NOK": 2, "NPR": 2, "NZD": 2, "PAB": 2, "PEN": 2, "PHP": 2, "PKR": 2,
+	"PLN": 2, "PYG": 0, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2, "SAR": 2, "SEK": 2, "SGD": 2, "THB": 2,
+	"TJS": 2, "TRY": 2, "TTD": 2, "TWD": 2, "TZS": 2, "UAH": 2, "UGX": 0, "USD": 2, "UYU": 2, "UZS": 2,
+	"VND": 0, "YER": 2, "ZAR": 2,
+	CurrencyStars: 0,
+}
+
+// Valid reports whether Telegram accepts the currency
+func (c Currency) Valid() bool {
+	_, ok := currencyExponents[c]
+	return ok
+}
+
+// Exponent returns the number of digits after the decimal point of the currency,
+// e.g. 2 for USD and 0 for JPY and Telegram Stars
+func (c Currency) Exponent() (int, error) {
+	exp, ok := currencyExponents[c]
+	if !ok {
+		return 0, fmt.Errorf("telegram: unsupported currency %q", string(c))
+	}
+	return exp, nil
+}
+
+// Amount converts a decimal amount like "9.99" to the integer in the smallest units that the payment
+// methods want (999 for USD). It fails if the amount has more decimals than the currency,
+// e.g. "1.5" Telegram Stars, instead of rounding it
+func (c Currency) Amount(decimal string) (int64, error) {
+	exp, err := c.Exponent()
+	if err != nil {
+		return 0, err
+	}
+
+	whole, fraction, _ := strings.Cut(strings.TrimSpace(decimal), ".")
+	if len(fraction) > exp {
+		return 0, fmt.Errorf("telegram: %s has at most %d decimals, got %q", string(c), exp, decimal)
+	}
+	if whole == "" || strings.ContainsAny(whole, "+-") || strings.ContainsAny(fraction, "+-") {
+		return 0, fmt.Errorf("telegram: invalid amount %q", decimal)
+	}
+	fraction += strings.Repeat("0", exp-len(fraction)) // "9.9" is 990 cents
+
+	amount, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("telegram: invalid amount %q", decimal)
+	}
+	return amount, nil
+}
+
+// Format writes an amount in the smallest units as a decimal, e.g. 999 USD is "9.99".
+// It is the inverse of Amount
+func (c Currency) Format(amount int64) (string, error) {
+	exp, err := c.Exponent()
+	if err != nil {
+		return "", err
+	}
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	s := strconv.FormatInt(amount, 10)
+	if exp == 0 {
+		return sign + s, nil
+	}
+	if len(s) <= exp {
+		s = strings.Repeat("0", exp-len(s)+1) + s
+	}
+	return sign + s[:len(s)-exp] + "." + s[len(s)-exp:], nil
+}
@@ -0,0 +1,103 @@
+/* currency_test.go : tests for the currencies and amounts of payments
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import "testing"
+
+func TestCurrencyAmount(t *testing.T) {
+	tests := []struct {
+		currency Currency
+		decimal  string
+		want     int64
+		wantErr  bool
+	}{
+		{currency: "USD", decimal: "9.99", want: 999},
+		{currency: "USD", decimal: "0.29", want: 29}, // 0.29*100 is 28.999... as a float
+		{currency: "USD", decimal: "9.9", want: 990},
+		{currency: "USD", decimal: "10", want: 1000},
+		{currency: "USD", decimal: " 1.50 ", want: 150},
+		{currency: "USD", decimal: "1.999", wantErr: true},
+		{currency: "USD", decimal: "-1.00", wantErr: true},
+		{currency: "USD", decimal: ".50", wantErr: true},
+		{currency: "USD", decimal: "1,50", wantErr: true},
+		{currency: "USD", decimal: "", wantErr: true},
+		{currency: "JPY", decimal: "999", want: 999},
+		{currency: "JPY", decimal: "999.5", wantErr: true},
+		{currency: "EUR", decimal: "0.01", want: 1},
+		{currency: CurrencyStars, decimal: "50", want: 50},
+		{currency: CurrencyStars, decimal: "1.5", wantErr: true},
+		{currency: "XXX", decimal: "1", wantErr: true},
+		{currency: "usd", decimal: "1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.currency)+" "+tt.decimal, func(t *testing.T) {
+			got, err := tt.currency.Amount(tt.decimal)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Amount() = %d, %v, wantErr %v", got, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Amount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyFormat(t *testing.T) {
+	tests := []struct {
+		currency Currency
+		amount   int64
+		want     string
+	}{
+		{currency: "USD", amount: 999, want: "9.99"},
+		{currency: "USD", amount: 5, want: "0.05"},
+		{currency: "USD", amount: 0, want: "0.00"},
+		{currency: "USD", amount: -150, want: "-1.50"},
+		{currency: "JPY", amount: 999, want: "999"},
+		{currency: CurrencyStars, amount: 50, want: "50"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.currency)+" "+tt.want, func(t *testing.T) {
+			got, err := tt.currency.Format(tt.amount)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Format(%d) = %q, want %q", tt.amount, got, tt.want)
+			}
+			if tt.amount >= 0 {
+				back, err := tt.currency.Amount(got)
+				if err != nil || back != tt.amount {
+					t.Errorf("Amount(%q) = %d, %v, want %d", got, back, err, tt.amount)
+				}
+			}
+		})
+	}
+	if _, err := Currency("XXX").Format(1); err == nil {
+		t.Error("Format accepted an unsupported currency")
+	}
+}
+
+func TestCurrencyExponent(t *testing.T) {
+	tests := []struct {
+		currency Currency
+		want     int
+	}{
+		{"USD", 2}, {"EUR", 2}, {"JPY", 0}, {"KRW", 0}, {"CLP", 0}, {"ISK", 0}, {CurrencyStars, 0},
+	}
+	for _, tt := range tests {
+		got, err := tt.currency.Exponent()
+		if err != nil || got != tt.want {
+			t.Errorf("%s.Exponent() = %d, %v, want %d", tt.currency, got, err, tt.want)
+		}
+		if !tt.currency.Valid() {
+			t.Errorf("%s is not valid", tt.currency)
+		}
+	}
+	if Currency("").Valid() {
+		t.Error("the empty currency is valid")
+	}
+}
@@ -0,0 +1,72 @@
+/* delete.go : deleting messages
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Maximum number of messages deleteMessages takes at once
+const MaxDeleteMessages = 100
+
+// Validate checks the parameters of deleteMessages
+func (p *DeleteMessagesParams) Validate() error {
+	if p.ChatID.IsZero() {
+		return fmt.Errorf("telegram: chat_id is required")
+	}
+	if n := len(p.MessageIDs); n < 1 || n > MaxDeleteMessages {
+		return fmt.Errorf("telegram: %d messages to delete, it must be 1-%d", n, MaxDeleteMessages)
+	}
+	return nil
+}
+
+// DeleteMessages calls deleteMessages
+func DeleteMessages(ctx context.Context, client *http.Client, token string, params DeleteMessagesParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "deleteMessages", &params)
+	return err
+}
+
+// DeleteChunkError is the failure of one of the deleteMessages calls made by DeleteUserMessages
+type DeleteChunkError struct {
+	// The messages of the chunk. Some of them may have been deleted anyway
+	MessageIDs []int64
+
+	// The error returned by deleteMessages
+	Err error
+}
+
+func (e *DeleteChunkError) Error() string {
+	return fmt.Sprintf("telegram: deleting %d messages starting from %d: %v", len(e.MessageIDs), e.MessageIDs[0], e.Err)
+}
+
+func (e *DeleteChunkError) Unwrap() error {
+	return e.Err
+}
+
+// DeleteUserMessages deletes any number of messages of a chat, e.g. the burst of a spammer, calling
+// deleteMessages on chunks of MaxDeleteMessages. A chunk that fails doesn't stop the others: the error
+// joins a *DeleteChunkError for each failed chunk (messages too old to be deleted make their chunk
+// fail only if none of it could be deleted). Only a cancelled ctx stops before the end
+func DeleteUserMessages(ctx context.Context, client *http.Client, token string, chatID ChatID, messageIDs []int64) error {
+	var errs []error
+	for start := 0; start < len(messageIDs); start += MaxDeleteMessages {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		chunk := messageIDs[start:min(start+MaxDeleteMessages, len(messageIDs))]
+		if err := DeleteMessages(ctx, client, token, DeleteMessagesParams{ChatID: chatID, MessageIDs: chunk}); err != nil {
+			errs = append(errs, &DeleteChunkError{MessageIDs: chunk, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,106 @@
+/* delete_test.go : tests for deleting messages
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func messageIDs(from, n int64) []int64 {
+	ids := make([]int64, n)
+	for i := range ids {
+		ids[i] = from + int64(i)
+	}
+	return ids
+}
+
+func TestDeleteUserMessagesChunks(t *testing.T) {
+	tests := []struct {
+		name       string
+		ids        []int64
+		wantChunks []int
+	}{
+		{name: "none", ids: nil, wantChunks: nil},
+		{name: "one", ids: messageIDs(1, 1), wantChunks: []int{1}},
+		{name: "exactly 100", ids: messageIDs(1, 100), wantChunks: []int{100}},
+		{name: "101", ids: messageIDs(1, 101), wantChunks: []int{100, 1}},
+		{name: "250", ids: messageIDs(1, 250), wantChunks: []int{100, 100, 50}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var chunks []int
+			var deleted []int64
+			client := fakeClient(func(method string, params []byte) string {
+				var p DeleteMessagesParams
+				if err := json.Unmarshal(params, &p); err != nil || method != "deleteMessages" {
+					t.Errorf("%s %s: %v", method, params, err)
+				}
+				chunks = append(chunks, len(p.MessageIDs))
+				deleted = append(deleted, p.MessageIDs...)
+				return `{"ok":true,"result":true}`
+			})
+			if err := DeleteUserMessages(context.Background(), client, "123:abc", NewChatID(-1001234567890), tt.ids); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(chunks, tt.wantChunks) {
+				t.Errorf("chunks of %v, want %v", chunks, tt.wantChunks)
+			}
+			if !slices.Equal(deleted, tt.ids) {
+				t.Errorf("deleted %d messages, want the %d given, in order", len(deleted), len(tt.ids))
+			}
+		})
+	}
+}
+
+func TestDeleteUserMessagesPartialFailure(t *testing.T) {
+	calls := 0
+	client := fakeClient(func(string, []byte) string {
+		calls++
+		if calls == 2 {
+			return `{"ok":false,"error_code":400,"description":"Bad Request: message can't be deleted"}`
+		}
+		return `{"ok":true,"result":true}`
+	})
+	ids := messageIDs(1000, 230)
+	err := DeleteUserMessages(context.Background(), client, "123:abc", NewChatID(-1001234567890), ids)
+	if calls != 3 {
+		t.Errorf("%d calls, want 3: a failed chunk must not stop the others", calls)
+	}
+
+	var chunkErr *DeleteChunkError
+	if !errors.As(err, &chunkErr) {
+		t.Fatalf("error %v is not a DeleteChunkError", err)
+	}
+	if !slices.Equal(chunkErr.MessageIDs, ids[100:200]) {
+		t.Errorf("failed chunk starts from %d with %d messages, want the second chunk", chunkErr.MessageIDs[0], len(chunkErr.MessageIDs))
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode != 400 {
+		t.Errorf("the APIError is not reachable from %v", err)
+	}
+}
+
+func TestDeleteUserMessagesCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	client := fakeClient(func(string, []byte) string {
+		calls++
+		cancel()
+		return `{"ok":true,"result":true}`
+	})
+	err := DeleteUserMessages(ctx, client, "123:abc", NewChatID(-1001234567890), messageIDs(1, 300))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+	if calls != 1 {
+		t.Errorf("%d calls after cancelling, want 1", calls)
+	}
+}
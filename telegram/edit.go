@@ -0,0 +1,104 @@
+/* edit.go : editing messages sent by the bot
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"net/http"
+)
+
+// EditParams returns the parameters to replace the text of the message messageID, sent in the
+// same chat, with the text of p. The inline keyboard of p is kept: an edit without it would remove
+// the one the message has. The fields that only make sense for a new message are dropped, and so is
+// any other kind of reply markup, since messages can only be edited to have an inline keyboard
+func (p *SendMessageParams) EditParams(messageID int64) EditMessageTextParams {
+	return EditMessageTextParams{
+		MessageLocator: ByChat(p.ChatID, messageID),
+		Text:           p.Text,
+		ParseMode:      p.ParseMode,
+		Entities:       p.Entities,
+		ReplyMarkup:    p.ReplyMarkup.Inline,
+	}
+}
+
+// UpsertMessage edits the message existingMessageID if it is not nil, otherwise it sends a new message.
+// It is the usual "update the menu if it's there" of settings bots: the inline keyboard of params is
+// sent in both cases (see EditParams). If the message already has that text, Telegram answers
+// "message is not modified": that is not an error here, and the returned Message then only has
+// MessageID and the chat of params set, since Telegram doesn't send it back. That is Chat.ID, or
+// Chat.Username if params.ChatID is a username: the numeric identifier isn't known in that case
+func UpsertMessage(ctx context.Context, client *http.Client, token string, existingMessageID *int64, params SendMessageParams) (*Message, error) {
+	if existingMessageID == nil {
+		if err := params.Validate(); err != nil {
+			return nil, err
+		}
+		m, err := Call[Message](ctx, client, token, "sendMessage", &params)
+		if err != nil {
+			return nil, err
+		}
+		return &m, nil
+	}
+
+	edit := params.EditParams(*existingMessageID)
+	if err := edit.Validate(); err != nil {
+		return nil, err
+	}
+	m, err := Call[Message](ctx, client, token, "editMessageText", &edit)
+	if IsMessageNotModified(err) {
+		return &Message{MessageID: *existingMessageID, Chat: Chat{ID: params.ChatID.ID, Username: params.ChatID.Username}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// UpdateKeyboard replaces the inline keyboard of the message with kb, e.g. to toggle a button of a menu.
+// "Message is not modified" (the keyboard was already kb) is not an error
+func UpdateKeyboard(ctx context.Context, client *http.Client, token string, locator MessageLocator, kb InlineKeyboardMarkup) error {
+	return editReplyMarkup(ctx, client, token, &EditMessageReplyMarkupParams{MessageLocator: locator, ReplyMarkup: &kb})
+}
+
+// ClearKeyboard removes the inline keyboard of the message.
+// "Message is not modified" (there was no keyboard) is not an error
+func ClearKeyboard(ctx context.Context, client *http.Client, token string, locator MessageLocator) error {
+	return editReplyMarkup(ctx, client, token, &EditMessageReplyMarkupParams{MessageLocator: locator, ReplyMarkup: RemoveInlineKeyboard()})
+}
+
+// EditMessageCaption calls editMessageCaption. The result is the edited Message, or nil for inline messages,
+// for which Telegram answers just True. "Message is not modified" is returned as is: see IsMessageNotModified
+func EditMessageCaption(ctx context.Context, client *http.Client, token string, params EditMessageCaptionParams) (*Message, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if params.InlineMessageID != "" {
+		_, err := callBool(ctx, client, token, "editMessageCaption", &params)
+		return nil, err
+	}
+	m, err := Call[Message](ctx, client, token, "editMessageCaption", &params)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func editReplyMarkup(ctx context.Context, client *http.Client, token string, params *EditMessageReplyMarkupParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	// The result is the edited Message, or True for inline messages: not needed here, but check it anyway
+	var err error
+	if params.InlineMessageID != "" {
+		_, err = callBool(ctx, client, token, "editMessageReplyMarkup", params)
+	} else {
+		_, err = Call[Message](ctx, client, token, "editMessageReplyMarkup", params)
+	}
+	if IsMessageNotModified(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,268 @@
+/* edit_test.go : tests for editing messages sent by the bot
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUpsertMessage(t *testing.T) {
+	existing := int64(9)
+	menu := &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "On", CallbackData: "toggle"}}}}
+	tests := []struct {
+		name       string
+		chatID     ChatID
+		existing   *int64
+		answer     string
+		wantMethod string
+		wantChat   Chat
+	}{
+		{
+			name:       "send",
+			chatID:     NewChatID(7),
+			answer:     `{"ok":true,"result":{"message_id":10,"date":0,"chat":{"id":7,"type":"private"}}}`,
+			wantMethod: "sendMessage",
+			wantChat:   Chat{ID: 7, Type: "private"},
+		},
+		{
+			name:       "edit",
+			chatID:     NewChatID(7),
+			existing:   &existing,
+			answer:     `{"ok":true,"result":{"message_id":9,"date":0,"chat":{"id":7,"type":"private"}}}`,
+			wantMethod: "editMessageText",
+			wantChat:   Chat{ID: 7, Type: "private"},
+		},
+		{
+			name:       "not modified",
+			chatID:     NewChatID(-1001234567890),
+			existing:   &existing,
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified: specified new message content and reply markup are exactly the same as a current content and reply markup of the message"}`,
+			wantMethod: "editMessageText",
+			wantChat:   Chat{ID: -1001234567890},
+		},
+		{
+			name:       "not modified, by username",
+			chatID:     NewChatUsername("channel"),
+			existing:   &existing,
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`,
+			wantMethod: "editMessageText",
+			wantChat:   Chat{Username: "channel"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var method string
+			var sent struct {
+				Text        string                `json:"text"`
+				MessageID   int64                 `json:"message_id"`
+				ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup"`
+			}
+			client := fakeClient(func(m string, params []byte) string {
+				method = m
+				if err := json.Unmarshal(params, &sent); err != nil {
+					t.Error(err)
+				}
+				return tt.answer
+			})
+
+			params := SendMessageParams{Text: "Settings"}
+			params.ChatID = tt.chatID
+			params.ReplyMarkup = ReplyMarkup{Inline: menu}
+			m, err := UpsertMessage(context.Background(), client, "123:abc", tt.existing, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if method != tt.wantMethod {
+				t.Errorf("called %s, want %s", method, tt.wantMethod)
+			}
+			if sent.ReplyMarkup == nil || len(sent.ReplyMarkup.InlineKeyboard) != 1 || sent.ReplyMarkup.InlineKeyboard[0][0].CallbackData != "toggle" {
+				t.Errorf("keyboard not sent: %+v", sent.ReplyMarkup)
+			}
+			if tt.existing != nil && (sent.MessageID != *tt.existing || m.MessageID != *tt.existing) {
+				t.Errorf("edited message %d, returned %d, want %d", sent.MessageID, m.MessageID, *tt.existing)
+			}
+			if m.Chat.ID != tt.wantChat.ID || m.Chat.Username != tt.wantChat.Username || m.Chat.Type != tt.wantChat.Type {
+				t.Errorf("Chat = %+v, want %+v", m.Chat, tt.wantChat)
+			}
+		})
+	}
+}
+
+func TestEditParamsReplyMarkup(t *testing.T) {
+	menu := &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "On", CallbackData: "toggle"}}}}
+	tests := []struct {
+		name   string
+		markup ReplyMarkup
+		want   *InlineKeyboardMarkup
+	}{
+		{name: "inline keyboard", markup: ReplyMarkup{Inline: menu}, want: menu},
+		{name: "no markup", markup: ReplyMarkup{}, want: nil},
+		{name: "reply keyboard is dropped", markup: ReplyMarkup{Remove: &ReplyKeyboardRemove{}}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := SendMessageParams{Text: "Settings"}
+			params.ChatID = NewChatID(7)
+			params.ReplyMarkup = tt.markup
+			if got := params.EditParams(9).ReplyMarkup; got != tt.want {
+				t.Errorf("ReplyMarkup = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditMessageCaption(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      EditMessageCaptionParams
+		answer      string
+		wantMessage bool
+		wantErr     bool
+	}{
+		{
+			name:        "message in a chat",
+			params:      EditMessageCaptionParams{MessageLocator: ByChat(NewChatID(7), 9), Caption: "new caption"},
+			answer:      `{"ok":true,"result":{"message_id":9,"date":0,"chat":{"id":7,"type":"private"},"caption":"new caption"}}`,
+			wantMessage: true,
+		},
+		{
+			name:   "inline message",
+			params: EditMessageCaptionParams{MessageLocator: ByInline("inline-1"), Caption: "new caption"},
+			answer: `{"ok":true,"result":true}`,
+		},
+		{
+			name:    "not modified",
+			params:  EditMessageCaptionParams{MessageLocator: ByChat(NewChatID(7), 9), Caption: "same"},
+			answer:  `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod string
+			var sent map[string]any
+			client := fakeClient(func(method string, params []byte) string {
+				gotMethod = method
+				json.Unmarshal(params, &sent)
+				return tt.answer
+			})
+			m, err := EditMessageCaption(context.Background(), client, "123:abc", tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EditMessageCaption() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (m != nil) != tt.wantMessage {
+				t.Errorf("EditMessageCaption() = %+v, want a message: %v", m, tt.wantMessage)
+			}
+			if m != nil && m.Caption != "new caption" {
+				t.Errorf("Caption = %q", m.Caption)
+			}
+			if gotMethod != "editMessageCaption" || sent["caption"] != tt.params.Caption {
+				t.Errorf("called %s with %v", gotMethod, sent)
+			}
+		})
+	}
+
+	// Both ways of locating the message at once are refused before the call
+	params := EditMessageCaptionParams{MessageLocator: MessageLocator{ChatID: NewChatID(7), MessageID: 9, InlineMessageID: "inline-1"}}
+	if _, err := EditMessageCaption(context.Background(), nil, "123:abc", params); err == nil {
+		t.Error("EditMessageCaption() with two targets succeeded")
+	}
+}
+
+func TestUpdateAndClearKeyboard(t *testing.T) {
+	menu := InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "✅ Sound", CallbackData: "toggle:sound"}}}}
+	const edited = `{"ok":true,"result":{"message_id":5,"date":1700000000,"chat":{"id":-100,"type":"supergroup"}}}`
+	const notModified = `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified: specified new message content and reply markup are exactly the same as a current content and reply markup of the message"}`
+	tests := []struct {
+		name       string
+		call       func(client *http.Client) error
+		answer     string
+		wantParams string
+		wantErr    bool
+	}{
+		{
+			name: "update by chat",
+			call: func(client *http.Client) error {
+				return UpdateKeyboard(context.Background(), client, "123:abc", ByChat(NewChatID(-100), 5), menu)
+			},
+			answer:     edited,
+			wantParams: `{"chat_id":-100,"message_id":5,"reply_markup":{"inline_keyboard":[[{"text":"✅ Sound","callback_data":"toggle:sound"}]]}}`,
+		},
+		{
+			name: "update inline",
+			call: func(client *http.Client) error {
+				return UpdateKeyboard(context.Background(), client, "123:abc", ByInline("AAEx"), menu)
+			},
+			answer:     `{"ok":true,"result":true}`,
+			wantParams: `{"inline_message_id":"AAEx","reply_markup":{"inline_keyboard":[[{"text":"✅ Sound","callback_data":"toggle:sound"}]]}}`,
+		},
+		{
+			name: "update not modified",
+			call: func(client *http.Client) error {
+				return UpdateKeyboard(context.Background(), client, "123:abc", ByChat(NewChatID(-100), 5), menu)
+			},
+			answer:     notModified,
+			wantParams: `{"chat_id":-100,"message_id":5,"reply_markup":{"inline_keyboard":[[{"text":"✅ Sound","callback_data":"toggle:sound"}]]}}`,
+		},
+		{
+			name: "clear by chat",
+			call: func(client *http.Client) error {
+				return ClearKeyboard(context.Background(), client, "123:abc", ByChat(NewChatID(-100), 5))
+			},
+			answer:     edited,
+			wantParams: `{"chat_id":-100,"message_id":5,"reply_markup":{"inline_keyboard":[]}}`,
+		},
+		{
+			name: "clear inline, not modified",
+			call: func(client *http.Client) error {
+				return ClearKeyboard(context.Background(), client, "123:abc", ByInline("AAEx"))
+			},
+			answer:     notModified,
+			wantParams: `{"inline_message_id":"AAEx","reply_markup":{"inline_keyboard":[]}}`,
+		},
+		{
+			name: "clear a deleted message",
+			call: func(client *http.Client) error {
+				return ClearKeyboard(context.Background(), client, "123:abc", ByChat(NewChatID(-100), 5))
+			},
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: message to edit not found"}`,
+			wantParams: `{"chat_id":-100,"message_id":5,"reply_markup":{"inline_keyboard":[]}}`,
+			wantErr:    true,
+		},
+		{
+			name: "no message",
+			call: func(client *http.Client) error {
+				return ClearKeyboard(context.Background(), client, "123:abc", MessageLocator{})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod, gotParams string
+			client := fakeClient(func(method string, params []byte) string {
+				gotMethod, gotParams = method, string(params)
+				return tt.answer
+			})
+			if err := tt.call(client); (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantParams == "" {
+				if gotMethod != "" {
+					t.Errorf("called %s for an invalid locator", gotMethod)
+				}
+				return
+			}
+			if gotMethod != "editMessageReplyMarkup" || gotParams != tt.wantParams {
+				t.Errorf("called %q with %s, want editMessageReplyMarkup with %s", gotMethod, gotParams, tt.wantParams)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+/* effects.go : message effects
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Effects are the animations played when a message is shown in a private chat.
+ * The Bot API takes them as opaque identifiers and doesn't list them, these are
+ * the ones of the effects every user has (the free ones in the message menu).
+ */
+
+package telegram
+
+const (
+	EffectFire       = "5104841245755180586" // 🔥
+	EffectThumbsUp   = "5107584321108051014" // 👍
+	EffectThumbsDown = "5104858069142078462" // 👎
+	EffectHeart      = "5159385139981059251" // ❤️
+	EffectParty      = "5046509860389126442" // 🎉
+	EffectPoo        = "5046589136895476101" // 💩
+)
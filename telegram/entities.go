@@ -0,0 +1,120 @@
+/* entities.go : building formatted text without markup
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Instead of a parse mode (and escaping every "_" and "*" of user input for
+ * MarkdownV2), a message can carry its formatting as entities: ranges of the
+ * text measured in UTF-16 code units. TextBuilder keeps track of the offsets,
+ * so emoji and other characters outside the BMP are counted correctly.
+ */
+
+package telegram
+
+import "strings"
+
+// A TextBuilder appends plain and formatted pieces of text, collecting the entities.
+// The zero value is ready to use. Use the result either as a message text or as a caption
+type TextBuilder struct {
+	text     strings.Builder
+	length   int64 // length of text in UTF-16 code units
+	entities []MessageEntity
+}
+
+// Text appends s without formatting
+func (b *TextBuilder) Text(s string) *TextBuilder {
+	b.text.WriteString(s)
+	b.length += UTF16Length(s)
+	return b
+}
+
+// Entity appends s as an entity of the given type (e.g. "bold", see the MessageEntity types)
+func (b *TextBuilder) Entity(entityType, s string) *TextBuilder {
+	return b.add(MessageEntity{Type: entityType}, s)
+}
+
+// Bold appends s in bold
+func (b *TextBuilder) Bold(s string) *TextBuilder { return b.Entity("bold", s) }
+
+// Italic appends s in italic
+func (b *TextBuilder) Italic(s string) *TextBuilder { return b.Entity("italic", s) }
+
+// Underline appends s underlined
+func (b *TextBuilder) Underline(s string) *TextBuilder { return b.Entity("underline", s) }
+
+// Strikethrough appends s struck through
+func (b *TextBuilder) Strikethrough(s string) *TextBuilder { return b.Entity("strikethrough", s) }
+
+// Spoiler appends s hidden behind a spoiler
+func (b *TextBuilder) Spoiler(s string) *TextBuilder { return b.Entity("spoiler", s) }
+
+// Code appends s as inline monospaced code
+func (b *TextBuilder) Code(s string) *TextBuilder { return b.Entity("code", s) }
+
+// Pre appends s as a block of code. language can be empty
+func (b *TextBuilder) Pre(s, language string) *TextBuilder {
+	return b.add(MessageEntity{Type: "pre", Language: language}, s)
+}
+
+// Link appends s as a link to url
+func (b *TextBuilder) Link(s, url string) *TextBuilder {
+	return b.add(MessageEntity{Type: "text_link", URL: url}, s)
+}
+
+// Mention appends s as a mention of the user, which works also for users without a username
+func (b *TextBuilder) Mention(s string, user *User) *TextBuilder {
+	return b.add(MessageEntity{Type: "text_mention", User: user}, s)
+}
+
+func (b *TextBuilder) add(e MessageEntity, s string) *TextBuilder {
+	e.Offset = b.length
+	e.Length = UTF16Length(s)
+	b.Text(s)
+	if e.Length > 0 { // Telegram rejects empty entities
+		b.entities = append(b.entities, e)
+	}
+	return b
+}
+
+// String returns the text built so far
+func (b *TextBuilder) String() string {
+	return b.text.String()
+}
+
+// Entities returns the entities of the text built so far
+func (b *TextBuilder) Entities() []MessageEntity {
+	return append([]MessageEntity(nil), b.entities...)
+}
+
+// Len returns the length of the text in UTF-16 code units, the unit of the Telegram limits
+func (b *TextBuilder) Len() int64 {
+	return b.length
+}
+
+// WithCaption returns a copy of the parameters with the caption and its entities taken from b.
+// The parse mode is cleared, since it can't be used together with entities
+func (p SendPhotoParams) WithCaption(b *TextBuilder) SendPhotoParams {
+	p.Caption, p.CaptionEntities, p.ParseMode = b.String(), b.Entities(), ParseModeNone
+	return p
+}
+
+// WithCaption returns a copy of the parameters with the caption and its entities taken from b.
+// The parse mode is cleared, since it can't be used together with entities
+func (p SendVideoParams) WithCaption(b *TextBuilder) SendVideoParams {
+	p.Caption, p.CaptionEntities, p.ParseMode = b.String(), b.Entities(), ParseModeNone
+	return p
+}
+
+// WithCaption returns a copy of the parameters with the caption and its entities taken from b.
+// The parse mode is cleared, since it can't be used together with entities
+func (p SendDocumentParams) WithCaption(b *TextBuilder) SendDocumentParams {
+	p.Caption, p.CaptionEntities, p.ParseMode = b.String(), b.Entities(), ParseModeNone
+	return p
+}
+
+// WithText returns a copy of the parameters with the text and its entities taken from b.
+// The parse mode is cleared, since it can't be used together with entities
+func (p SendMessageParams) WithText(b *TextBuilder) SendMessageParams {
+	p.Text, p.Entities, p.ParseMode = b.String(), b.Entities(), ParseModeNone
+	return p
+}
@@ -0,0 +1,133 @@
+/* entities_test.go : tests for building formatted text without markup
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTextBuilderOffsets(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(b *TextBuilder)
+		text  string
+		want  []MessageEntity
+	}{
+		{
+			name:  "ascii",
+			build: func(b *TextBuilder) { b.Text("Hello ").Bold("world") },
+			text:  "Hello world",
+			want:  []MessageEntity{{Type: "bold", Offset: 6, Length: 5}},
+		},
+		{
+			name: "emoji before an entity",
+			build: func(b *TextBuilder) {
+				b.Text("📷 ").Bold("Sunset").Text(" at ").Link("the beach", "https://example.com")
+			},
+			text: "📷 Sunset at the beach",
+			want: []MessageEntity{
+				{Type: "bold", Offset: 3, Length: 6},
+				{Type: "text_link", Offset: 13, Length: 9, URL: "https://example.com"},
+			},
+		},
+		{
+			name:  "emoji inside an entity",
+			build: func(b *TextBuilder) { b.Italic("🌅🌊").Text("!").Spoiler("é😀") },
+			text:  "🌅🌊!é😀",
+			want: []MessageEntity{
+				{Type: "italic", Offset: 0, Length: 4},
+				{Type: "spoiler", Offset: 5, Length: 3},
+			},
+		},
+		{
+			name:  "flag and zwj sequence",
+			build: func(b *TextBuilder) { b.Text("🇮🇹👨‍💻").Code("go") },
+			text:  "🇮🇹👨‍💻go",
+			want:  []MessageEntity{{Type: "code", Offset: 9, Length: 2}},
+		},
+		{
+			name:  "empty entity is dropped",
+			build: func(b *TextBuilder) { b.Text("a").Bold("").Pre("x", "go") },
+			text:  "ax",
+			want:  []MessageEntity{{Type: "pre", Offset: 1, Length: 1, Language: "go"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b TextBuilder
+			tt.build(&b)
+			if b.String() != tt.text {
+				t.Errorf("String() = %q, want %q", b.String(), tt.text)
+			}
+			if b.Len() != UTF16Length(tt.text) {
+				t.Errorf("Len() = %d, want %d", b.Len(), UTF16Length(tt.text))
+			}
+			got := b.Entities()
+			if len(got) != len(tt.want) {
+				t.Fatalf("Entities() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				g, w := got[i], tt.want[i]
+				if g.Type != w.Type || g.Offset != w.Offset || g.Length != w.Length || g.URL != w.URL || g.Language != w.Language {
+					t.Errorf("entity %d = %+v, want %+v", i, g, w)
+				}
+			}
+		})
+	}
+}
+
+// The text of each entity, cut with the offsets the builder computed, is the piece that was appended
+func TestTextBuilderEntityText(t *testing.T) {
+	var b TextBuilder
+	pieces := []string{"🔥 hot", "naïve", "日本語", "👍🏽"}
+	b.Text("→ ")
+	for _, p := range pieces {
+		b.Bold(p).Text(" · ")
+	}
+	for i, e := range b.Entities() {
+		if got := EntityText(b.String(), e); got != pieces[i] {
+			t.Errorf("entity %d covers %q, want %q", i, got, pieces[i])
+		}
+	}
+}
+
+func TestCaptionBuilderLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		build   func(b *TextBuilder)
+		wantErr bool
+	}{
+		{name: "emoji at the limit", build: func(b *TextBuilder) { b.Bold(strings.Repeat("😀", MaxCaptionLength/2)) }},
+		{name: "emoji over the limit", build: func(b *TextBuilder) { b.Bold(strings.Repeat("😀", MaxCaptionLength/2)).Text("!") }, wantErr: true},
+		{name: "runes under the limit, units over it", build: func(b *TextBuilder) { b.Text(strings.Repeat("🌊", 600)) }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b TextBuilder
+			tt.build(&b)
+			photo := SendPhotoParams{ParseMode: ParseModeHTML}.WithCaption(&b)
+			photo.ChatID = ChatID{ID: 7}
+			if photo.ParseMode != ParseModeNone {
+				t.Errorf("WithCaption kept the parse mode %q", photo.ParseMode)
+			}
+			if err := photo.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("photo Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			video := SendVideoParams{}.WithCaption(&b)
+			video.ChatID = ChatID{ID: 7}
+			if err := video.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("video Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			document := SendDocumentParams{}.WithCaption(&b)
+			document.ChatID = ChatID{ID: 7}
+			if err := document.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("document Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
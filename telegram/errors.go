@@ -0,0 +1,97 @@
+/* errors.go : errors returned by the Bot API
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Every answer of the Bot API is a JSON object with a boolean "ok".
+ * When it is false, the object has a human-readable "description", an
+ * "error_code" (an HTTP status code, in practice) and sometimes some
+ * "parameters" that help handling the error automatically.
+ */
+
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// APIError is an unsuccessful answer of the Bot API.
+// It decodes directly from the JSON of the answer
+type APIError struct {
+	// Error code. Its meaning is subject to change in the future
+	ErrorCode int `json:"error_code"`
+
+	// Human-readable description of the error
+	Description string `json:"description"`
+
+	// [Optional] Information that can help to automatically handle the error
+	Parameters *ResponseParameters `json:"parameters,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("telegram: %d %s", e.ErrorCode, e.Description)
+}
+
+// MigrateToChatID returns the identifier of the supergroup the target group was migrated to.
+// The boolean is false if the error is not about a migration. Repeat the request
+// with the new identifier (and store it in place of the old one)
+func (e *APIError) MigrateToChatID() (int64, bool) {
+	if e.Parameters == nil || e.Parameters.MigrateToChatID == 0 {
+		return 0, false
+	}
+	return e.Parameters.MigrateToChatID, true
+}
+
+// The conditions below have no code of their own: Telegram tells them apart only by the
+// description, so we match the error code and a piece of the description.
+// If err is not (or doesn't wrap) an *APIError, they are all false
+
+func matchAPIError(err error, code int, description string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.ErrorCode == code && strings.Contains(strings.ToLower(apiErr.Description), description)
+}
+
+// IsBotBlocked reports whether the user blocked the bot. Broadcasting bots should stop writing to them
+func IsBotBlocked(err error) bool {
+	return matchAPIError(err, 403, "bot was blocked by the user")
+}
+
+// IsChatNotFound reports whether the chat doesn't exist or the bot has never been in it
+func IsChatNotFound(err error) bool {
+	return matchAPIError(err, 400, "chat not found")
+}
+
+// IsMessageNotModified reports whether an edit was refused because nothing would change.
+// It is usually harmless, e.g. when a user presses the same button twice
+func IsMessageNotModified(err error) bool {
+	return matchAPIError(err, 400, "message is not modified")
+}
+
+// IsChatNotModified reports whether setChatTitle or setChatDescription was refused because the chat
+// already has that title or description (the error is "chat title is not modified" or
+// "chat description is not modified"). Bots that reconcile the state of their chats can ignore it
+func IsChatNotModified(err error) bool {
+	return matchAPIError(err, 400, "chat title is not modified") ||
+		matchAPIError(err, 400, "chat description is not modified")
+}
+
+// IsMessageToEditNotFound reports whether the message to edit doesn't exist (anymore)
+func IsMessageToEditNotFound(err error) bool {
+	return matchAPIError(err, 400, "message to edit not found")
+}
+
+// IsNotEnoughRights reports whether the bot lacks the administrator rights the action needs
+func IsNotEnoughRights(err error) bool {
+	return matchAPIError(err, 400, "not enough rights")
+}
+
+// IsQueryTooOld reports whether a callback or inline query was answered too late (after about 15 minutes)
+// or was already answered. There is nothing left to do about it
+func IsQueryTooOld(err error) bool {
+	return matchAPIError(err, 400, "query is too old")
+}
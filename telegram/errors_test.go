@@ -0,0 +1,75 @@
+/* errors_test.go : tests for the errors of the API
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestDecodeMigrationServiceMessage(t *testing.T) {
+	data := `{
+		"message_id": 1, "date": 1700000000,
+		"chat": {"id": -4567, "type": "group", "title": "Old group"},
+		"from": {"id": 7, "is_bot": false, "first_name": "Ann"},
+		"migrate_to_chat_id": -1001234567890
+	}`
+	var m Message
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.MigrateToChatID != -1001234567890 {
+		t.Errorf("MigrateToChatID = %d", m.MigrateToChatID)
+	}
+	if !m.IsServiceMessage() {
+		t.Error("a migration is a service message")
+	}
+}
+
+func TestAPIErrorMigrateToChatID(t *testing.T) {
+	body := `{"ok": false, "error_code": 400, "description": "Bad Request: group chat was upgraded to a supergroup chat",
+		"parameters": {"migrate_to_chat_id": -1001234567890}}`
+	err := DecodeResponse([]byte(body), nil)
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("got %T, want *APIError", err)
+	}
+	id, ok := apiErr.MigrateToChatID()
+	if !ok || id != -1001234567890 {
+		t.Errorf("MigrateToChatID() = %d, %v", id, ok)
+	}
+
+	other := &APIError{ErrorCode: 400, Description: "Bad Request: chat not found"}
+	if _, ok := other.MigrateToChatID(); ok {
+		t.Error("an error without parameters is not a migration")
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		match func(error) bool
+		want  bool
+	}{
+		{"blocked", &APIError{403, "Forbidden: bot was blocked by the user", nil}, IsBotBlocked, true},
+		{"not modified", &APIError{400, "Bad Request: message is not modified: specified new message content and reply markup are exactly the same", nil}, IsMessageNotModified, true},
+		{"wrapped", fmt.Errorf("editing: %w", &APIError{400, "Bad Request: message is not modified", nil}), IsMessageNotModified, true},
+		{"other code", &APIError{403, "Bad Request: message is not modified", nil}, IsMessageNotModified, false},
+		{"too old", &APIError{400, "Bad Request: query is too old and response timeout expired or query ID is invalid", nil}, IsQueryTooOld, true},
+		{"title", &APIError{400, "Bad Request: chat title is not modified", nil}, IsChatNotModified, true},
+		{"description", &APIError{400, "Bad Request: chat description is not modified", nil}, IsChatNotModified, true},
+		{"not an API error", fmt.Errorf("message is not modified"), IsMessageNotModified, false},
+		{"nil", nil, IsChatNotFound, false},
+	}
+	for _, tt := range tests {
+		if got := tt.match(tt.err); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
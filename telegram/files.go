@@ -0,0 +1,187 @@
+/* files.go : downloading files
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Bots can download files of up to 20 MB. For bigger files getFile doesn't return a file_path
+const MaxDownloadFileSize = 20 * 1024 * 1024
+
+// URL returns the link to download the file, valid for at least one hour after getFile.
+// It fails if the File has no path, which happens when the file is bigger than MaxDownloadFileSize
+func (f *File) URL(token string) (string, error) {
+	if f.FilePath == "" {
+		if f.FileSize > MaxDownloadFileSize {
+			return "", fmt.Errorf("telegram: file %s is %d bytes, bots can only download files up to %d bytes", f.FileID, f.FileSize, MaxDownloadFileSize)
+		}
+		return "", fmt.Errorf("telegram: file %s has no file_path, call getFile first", f.FileID)
+	}
+	return apiBaseURL + "/file/bot" + token + "/" + f.FilePath, nil
+}
+
+// SaveFile downloads the file (the result of getFile) to destPath, creating the parent directories.
+// If anything goes wrong the partial file is removed, so destPath either has the whole file or doesn't exist.
+// A nil client means http.DefaultClient
+func SaveFile(ctx context.Context, client *http.Client, token string, file *File, destPath string) error {
+	url, err := file.URL(token)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("telegram: %w", redactToken(err, token))
+	}
+	req.Header.Set("User-Agent", DefaultUserAgent)
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("telegram: downloading file %s: %w", file.FileID, redactToken(err, token))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("telegram: downloading file %s: %s", file.FileID, resp.Status)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	// Write next to the destination and rename at the end, like FileOffsetStore does
+	tmp, err := os.CreateTemp(filepath.Dir(destPath), filepath.Base(destPath)+".part*")
+	if err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	// CreateTemp makes the file readable only by the owner, saved media should look like any other file
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("telegram: downloading file %s: %w", file.FileID, err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), destPath); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// SaveFileByID gets the path of the file with getFile and downloads it to destPath like SaveFile.
+// Files bigger than MaxDownloadFileSize have no path, and are reported as an error before downloading anything
+func SaveFileByID(ctx context.Context, client *http.Client, token, fileID, destPath string) error {
+	file, err := GetFile(ctx, client, token, fileID)
+	if err != nil {
+		return err
+	}
+	return SaveFile(ctx, client, token, file, destPath)
+}
+
+// ProgressReader wraps a reader and reports how many bytes went through it, to show the progress of an upload
+type ProgressReader struct {
+	r        io.Reader
+	sent     int64
+	total    int64
+	progress func(sent, total int64)
+}
+
+// NewProgressReader returns a reader that calls progress after each read from r with the bytes read so far
+// and total, the expected size. Pass a total of -1 if the size is unknown: it is passed as is to progress
+func NewProgressReader(r io.Reader, total int64, progress func(sent, total int64)) *ProgressReader {
+	return &ProgressReader{r: r, total: total, progress: progress}
+}
+
+// Read reads from the wrapped reader and reports the progress if something was read
+func (p *ProgressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.progress(p.sent, p.total)
+	}
+	return n, err
+}
+
+// GetFile calls getFile, to get the path needed to download a file. A nil client means http.DefaultClient
+func GetFile(ctx context.Context, client *http.Client, token, fileID string) (*File, error) {
+	if fileID == "" {
+		return nil, fmt.Errorf("telegram: file_id is required")
+	}
+	file, err := Call[File](ctx, client, token, "getFile", &GetFileParams{FileID: fileID})
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// How long FileCache keeps a File by default. The download links last at least one hour,
+// so this leaves a wide margin
+const DefaultFileCacheTTL = 10 * time.Minute
+
+// The function that gets a File from its file_id, usually GetFile
+type FileFetcher func(ctx context.Context, fileID string) (*File, error)
+
+// FileCache remembers the results of getFile for a while, so that downloading the same file again
+// doesn't need another call. It is safe for concurrent use
+type FileCache struct {
+	fetch FileFetcher
+	files *ttlCache[string, *File]
+}
+
+// NewFileCache returns a FileCache that calls fetch for the files it doesn't have, and keeps them for ttl
+// (DefaultFileCacheTTL if ttl is 0)
+func NewFileCache(ttl time.Duration, fetch FileFetcher) *FileCache {
+	return &FileCache{fetch: fetch, files: newTTLCache[string, *File](ttl, DefaultFileCacheTTL)}
+}
+
+// Get returns the File with the given file_id, from the cache if it is fresh enough
+func (c *FileCache) Get(ctx context.Context, fileID string) (*File, error) {
+	if file, ok := c.files.get(fileID); ok {
+		return file, nil
+	}
+
+	file, err := c.fetch(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	c.files.set(fileID, file)
+	return file, nil
+}
+
+// Invalidate forgets the file, e.g. because its link stopped working
+func (c *FileCache) Invalidate(fileID string) {
+	c.files.delete(fileID)
+}
+
+// Save downloads the file with the given file_id to destPath like SaveFile, getting its path from the cache.
+// If the download fails the file is invalidated, since the cached path may be the problem
+func (c *FileCache) Save(ctx context.Context, client *http.Client, token, fileID, destPath string) error {
+	file, err := c.Get(ctx, fileID)
+	if err != nil {
+		return err
+	}
+	if err := SaveFile(ctx, client, token, file, destPath); err != nil {
+		c.Invalidate(fileID)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+/* files_test.go : tests for downloading files
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// A fake Bot API that answers getFile with fileJSON and serves content (with status) for every download
+func fakeFileServer(fileJSON string, status int, content string) *http.Client {
+	return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := content
+		code := status
+		if !strings.HasPrefix(req.URL.Path, "/file/") {
+			body, code = `{"ok":true,"result":`+fileJSON+`}`, http.StatusOK
+		}
+		return &http.Response{StatusCode: code, Status: http.StatusText(code), Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})}
+}
+
+func TestSaveFileByID(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileJSON string
+		status   int
+		wantErr  bool
+	}{
+		{name: "downloaded", fileJSON: `{"file_id":"f1","file_unique_id":"u1","file_size":5,"file_path":"photos/file_1.jpg"}`, status: http.StatusOK},
+		{name: "too big", fileJSON: `{"file_id":"f1","file_unique_id":"u1","file_size":30000000}`, status: http.StatusOK, wantErr: true},
+		{name: "download fails", fileJSON: `{"file_id":"f1","file_unique_id":"u1","file_path":"photos/file_1.jpg"}`, status: http.StatusNotFound, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			dest := filepath.Join(dir, "media", "photo.jpg")
+			client := fakeFileServer(tt.fileJSON, tt.status, "hello")
+
+			err := SaveFileByID(context.Background(), client, "123:abc", "f1", dest)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SaveFileByID() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if _, err := os.Stat(dest); !os.IsNotExist(err) {
+					t.Errorf("%s exists after a failure", dest)
+				}
+				entries, _ := os.ReadDir(filepath.Dir(dest))
+				if len(entries) != 0 {
+					t.Errorf("partial files left behind: %v", entries)
+				}
+				return
+			}
+			data, err := os.ReadFile(dest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != "hello" {
+				t.Errorf("saved %q, want %q", data, "hello")
+			}
+			if info, err := os.Stat(dest); err == nil && runtime.GOOS != "windows" && info.Mode().Perm() != 0o644 {
+				t.Errorf("saved with mode %v, want 0644", info.Mode().Perm())
+			}
+		})
+	}
+}
+
+// A Bot API on an httptest server that counts the getFile calls. Downloads fail while *failing is true
+func fileCacheServer(t *testing.T, getFileCalls *atomic.Int64, failing *atomic.Bool) *http.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bot123:abc/getFile":
+			getFileCalls.Add(1)
+			io.WriteString(w, `{"ok":true,"result":{"file_id":"f1","file_unique_id":"u1","file_size":5,"file_path":"photos/file_1.jpg"}}`)
+		case "/file/bot123:abc/photos/file_1.jpg":
+			if failing.Load() {
+				http.NotFound(w, r)
+				return
+			}
+			io.WriteString(w, "hello")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	// Send to the server the requests meant for api.telegram.org
+	target, _ := url.Parse(server.URL)
+	return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+		return server.Client().Transport.RoundTrip(req)
+	})}
+}
+
+func TestFileCacheSave(t *testing.T) {
+	var getFileCalls atomic.Int64
+	var failing atomic.Bool
+	client := fileCacheServer(t, &getFileCalls, &failing)
+	cache := NewFileCache(0, func(ctx context.Context, fileID string) (*File, error) {
+		return GetFile(ctx, client, "123:abc", fileID)
+	})
+	now := time.Now()
+	cache.files.now = func() time.Time { return now }
+	dest := filepath.Join(t.TempDir(), "photo.jpg")
+
+	save := func(wantCalls int64, wantErr bool) {
+		t.Helper()
+		if err := cache.Save(context.Background(), client, "123:abc", "f1", dest); (err != nil) != wantErr {
+			t.Fatalf("Save() = %v, wantErr %v", err, wantErr)
+		}
+		if got := getFileCalls.Load(); got != wantCalls {
+			t.Errorf("%d getFile calls, want %d", got, wantCalls)
+		}
+	}
+
+	save(1, false)
+	// Within the ttl the path comes from the cache
+	save(1, false)
+	now = now.Add(DefaultFileCacheTTL - time.Second)
+	save(1, false)
+
+	// After the ttl getFile is called again
+	now = now.Add(time.Second)
+	save(2, false)
+
+	// A failed download invalidates the file, so the next one asks again
+	failing.Store(true)
+	save(2, true)
+	failing.Store(false)
+	save(3, false)
+
+	if data, err := os.ReadFile(dest); err != nil || string(data) != "hello" {
+		t.Errorf("saved %q, %v", data, err)
+	}
+}
+
+func TestProgressReader(t *testing.T) {
+	const content = "hello"
+	tests := []struct {
+		name  string
+		total int64
+	}{
+		{"known size", int64(len(content))},
+		{"unknown size", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent []int64
+			r := NewProgressReader(iotest.OneByteReader(strings.NewReader(content)), tt.total, func(n, total int64) {
+				if total != tt.total {
+					t.Errorf("progress got total %d, want %d", total, tt.total)
+				}
+				sent = append(sent, n)
+			})
+			data, err := io.ReadAll(r)
+			if err != nil || string(data) != content {
+				t.Fatalf("read %q, %v", data, err)
+			}
+			// One call for each byte, none for the final EOF
+			if want := []int64{1, 2, 3, 4, 5}; !slices.Equal(sent, want) {
+				t.Errorf("progress got %v, want %v", sent, want)
+			}
+		})
+	}
+}
+
+func TestProgressReaderUpload(t *testing.T) {
+	const content = "-----BEGIN CERTIFICATE-----"
+	var last int64
+	client := fakeClient(func(method string, params []byte) string {
+		return `{"ok":true,"result":true}`
+	})
+	cert := &InputFile{Name: "cert.pem", Reader: NewProgressReader(strings.NewReader(content), int64(len(content)), func(sent, total int64) {
+		if sent < last {
+			t.Errorf("progress went back from %d to %d", last, sent)
+		}
+		last = sent
+	})}
+	if err := SetWebhook(context.Background(), client, "123:abc", SetWebhookParams{URL: "https://example.com/hook", Certificate: cert}); err != nil {
+		t.Fatal(err)
+	}
+	if last != int64(len(content)) {
+		t.Errorf("progress stopped at %d bytes, want %d", last, len(content))
+	}
+}
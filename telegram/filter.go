@@ -0,0 +1,109 @@
+/* filter.go : predicates on updates
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * A Filter says whether an update is interesting. The built-in ones cover
+ * the usual cases and combine with And, Or and Not; a custom one is just a
+ * function, e.g.
+ *
+ *	isLong := func(u *telegram.Update) bool {
+ *		m := u.EffectiveMessage()
+ *		return m != nil && len(m.Text) > 1000
+ *	}
+ *	f := telegram.And(telegram.ChatTypeIs("private"), telegram.Not(isLong))
+ */
+
+package telegram
+
+// A Filter reports whether an update matches. It must not modify the update
+type Filter func(u *Update) bool
+
+// And matches the updates that match all the filters (and all updates if there are none).
+// It stops at the first filter that doesn't match
+func And(filters ...Filter) Filter {
+	return func(u *Update) bool {
+		for _, f := range filters {
+			if !f(u) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or matches the updates that match at least one of the filters (and no update if there are none).
+// It stops at the first filter that matches
+func Or(filters ...Filter) Filter {
+	return func(u *Update) bool {
+		for _, f := range filters {
+			if f(u) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not matches the updates that f doesn't match
+func Not(f Filter) Filter {
+	return func(u *Update) bool { return !f(u) }
+}
+
+// HasText matches the updates with a message (see Update.EffectiveMessage) that has text
+func HasText(u *Update) bool {
+	m := u.EffectiveMessage()
+	return m != nil && m.Text != ""
+}
+
+// CommandIs matches the messages that start with the command name, given without the "/"
+// (e.g. CommandIs("start") matches "/start" and "/start@mybot")
+func CommandIs(name string) Filter {
+	return func(u *Update) bool {
+		m := u.EffectiveMessage()
+		if m == nil {
+			return false
+		}
+		command, _, ok := m.Command()
+		return ok && command == name
+	}
+}
+
+// ChatTypeIs matches the updates that happen in a chat of one of the given types:
+// "private", "group", "supergroup" or "channel"
+func ChatTypeIs(types ...string) Filter {
+	return func(u *Update) bool {
+		c := u.EffectiveChat()
+		if c == nil {
+			return false
+		}
+		for _, t := range types {
+			if c.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// FromUser matches the updates caused by one of the given users
+func FromUser(ids ...int64) Filter {
+	set := NewIDSet(ids...)
+	return func(u *Update) bool {
+		user := u.EffectiveUser()
+		return user != nil && set.Contains(user.ID)
+	}
+}
+
+// UpdateTypeIs matches the updates of one of the given types (see the UpdateType constants)
+func UpdateTypeIs(types ...string) Filter {
+	return func(u *Update) bool {
+		kind := u.Type()
+		for _, t := range types {
+			if kind == t {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,108 @@
+/* filter_test.go : tests for the predicates on updates
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import "testing"
+
+// A filter that always answers match, and counts how many times it was asked
+func counting(match bool, calls *int) Filter {
+	return func(u *Update) bool {
+		*calls++
+		return match
+	}
+}
+
+func TestFilterCombinators(t *testing.T) {
+	yes := func(u *Update) bool { return true }
+	no := func(u *Update) bool { return false }
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"And of nothing", And(), true},
+		{"And all true", And(yes, yes), true},
+		{"And one false", And(yes, no), false},
+		{"Or of nothing", Or(), false},
+		{"Or one true", Or(no, yes), true},
+		{"Or all false", Or(no, no), false},
+		{"Not true", Not(yes), false},
+		{"Not false", Not(no), true},
+		{"nested", And(Or(no, yes), Not(And(yes, no))), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter(&Update{}); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterShortCircuit(t *testing.T) {
+	var first, second int
+	And(counting(false, &first), counting(true, &second))(&Update{})
+	if first != 1 || second != 0 {
+		t.Errorf("And asked %d and %d times, want 1 and 0", first, second)
+	}
+
+	first, second = 0, 0
+	Or(counting(true, &first), counting(false, &second))(&Update{})
+	if first != 1 || second != 0 {
+		t.Errorf("Or asked %d and %d times, want 1 and 0", first, second)
+	}
+}
+
+func TestBuiltInFilters(t *testing.T) {
+	start := &Update{Message: &Message{
+		Chat:     Chat{ID: 42, Type: "private"},
+		From:     &User{ID: 42},
+		Text:     "/start@mybot now",
+		Entities: []MessageEntity{{Type: "bot_command", Offset: 0, Length: 12}},
+	}}
+	group := &Update{Message: &Message{
+		Chat: Chat{ID: -100, Type: "supergroup"},
+		From: &User{ID: 7},
+		Text: "hello /start",
+		// A command that isn't at the beginning doesn't count
+		Entities: []MessageEntity{{Type: "bot_command", Offset: 6, Length: 6}},
+	}}
+	photo := &Update{Message: &Message{Chat: Chat{ID: -100, Type: "supergroup"}, From: &User{ID: 7}}}
+	query := &Update{InlineQuery: &InlineQuery{ID: "q", From: User{ID: 42}}}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		update *Update
+		want   bool
+	}{
+		{"HasText, text", HasText, group, true},
+		{"HasText, no text", HasText, photo, false},
+		{"HasText, no message", HasText, query, false},
+		{"CommandIs, with bot name", CommandIs("start"), start, true},
+		{"CommandIs, other command", CommandIs("help"), start, false},
+		{"CommandIs, not at the beginning", CommandIs("start"), group, false},
+		{"CommandIs, no message", CommandIs("start"), query, false},
+		{"ChatTypeIs, private", ChatTypeIs("private"), start, true},
+		{"ChatTypeIs, one of many", ChatTypeIs("group", "supergroup"), group, true},
+		{"ChatTypeIs, other type", ChatTypeIs("private"), group, false},
+		{"ChatTypeIs, no chat", ChatTypeIs("private"), query, false},
+		{"FromUser, listed", FromUser(1, 42), query, true},
+		{"FromUser, not listed", FromUser(1, 42), group, false},
+		{"UpdateTypeIs, message", UpdateTypeIs(UpdateTypeMessage), group, true},
+		{"UpdateTypeIs, inline query", UpdateTypeIs(UpdateTypeMessage, UpdateTypeInlineQuery), query, true},
+		{"UpdateTypeIs, other type", UpdateTypeIs(UpdateTypeCallbackQuery), query, false},
+		{"combined", And(ChatTypeIs("private"), CommandIs("start"), Not(FromUser(7))), start, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter(tt.update); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/* format.go : readable output for logs
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Printing a Message with %v dumps dozens of nil pointers and zeros. The types
+ * here print a short summary instead with %v and %s, while %+v and %#v still
+ * show every field, for when the summary is not enough. The methods have value
+ * receivers, so that a value and a pointer to it print the same way.
+ */
+
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Texts longer than this (in runes) are cut in the summaries
+const formatTextLength = 32
+
+// String returns "@username (id)", or the name of the user if they have no username
+func (u User) String() string {
+	if u.Username != "" {
+		return "@" + u.Username + " (" + strconv.FormatInt(u.ID, 10) + ")"
+	}
+	return fullName(u.FirstName, u.LastName) + " (" + strconv.FormatInt(u.ID, 10) + ")"
+}
+
+// Format prints String with %v and %s, and all the fields with %+v and %#v
+func (u User) Format(f fmt.State, verb rune) {
+	type plain User // same fields without the methods
+	formatWith(f, verb, u.String(), plain(u), "User")
+}
+
+// String returns the type of the chat, followed by its username, title or name and its id,
+// e.g. `supergroup "Gophers" (-1001234567890)`
+func (c Chat) String() string {
+	var name string
+	switch {
+	case c.Username != "":
+		name = "@" + c.Username
+	case c.Title != "":
+		name = strconv.Quote(c.Title)
+	default:
+		name = fullName(c.FirstName, c.LastName)
+	}
+	return c.Type + " " + name + " (" + strconv.FormatInt(c.ID, 10) + ")"
+}
+
+// Format prints String with %v and %s, and all the fields with %+v and %#v
+func (c Chat) Format(f fmt.State, verb rune) {
+	type plain Chat
+	formatWith(f, verb, c.String(), plain(c), "Chat")
+}
+
+// String returns the id, chat and sender of the message followed by the beginning of its text
+// or caption, e.g. `#42 in private @john (7) from @john (7): "hello"`.
+// Messages without text say what they are (service or media)
+func (m Message) String() string {
+	var b strings.Builder
+	b.WriteString("#" + strconv.FormatInt(m.MessageID, 10) + " in " + m.Chat.String())
+	if m.From != nil {
+		b.WriteString(" from " + m.From.String())
+	}
+	switch {
+	case m.Text != "":
+		b.WriteString(": " + strconv.Quote(truncate(m.Text, formatTextLength)))
+	case m.Caption != "":
+		b.WriteString(": media " + strconv.Quote(truncate(m.Caption, formatTextLength)))
+	case m.IsServiceMessage():
+		b.WriteString(": service message")
+	default:
+		b.WriteString(": media")
+	}
+	return b.String()
+}
+
+// Format prints String with %v and %s, and all the fields with %+v and %#v
+func (m Message) Format(f fmt.State, verb rune) {
+	type plain Message
+	formatWith(f, verb, m.String(), plain(m), "Message")
+}
+
+// String returns the id and type of the update with the chat and user it comes from,
+// e.g. "update 1001 (message, chat -1001234567890, user 7)"
+func (u Update) String() string {
+	kind := u.Type()
+	if kind == "" {
+		kind = "unknown"
+	}
+	s := "update " + strconv.FormatInt(u.UpdateID, 10) + " (" + kind
+	if c := u.EffectiveChat(); c != nil {
+		s += ", chat " + strconv.FormatInt(c.ID, 10)
+	}
+	if user := u.EffectiveUser(); user != nil {
+		s += ", user " + strconv.FormatInt(user.ID, 10)
+	} else if sender := u.EffectiveSenderChat(); sender != nil && sender.ID != u.EffectiveChat().ID {
+		s += ", sender chat " + strconv.FormatInt(sender.ID, 10)
+	}
+	return s + ")"
+}
+
+// Format prints String with %v and %s, and all the fields with %+v and %#v
+func (u Update) Format(f fmt.State, verb rune) {
+	type plain Update
+	formatWith(f, verb, u.String(), plain(u), "Update")
+}
+
+// Prints summary, unless the verb asks for the fields (%+v, %#v): in that case it prints plain,
+// a copy of the value whose type has no Format method, so that fmt does its usual thing.
+// With %#v the local type of plain is renamed back to name, so the output is valid Go again
+func formatWith(f fmt.State, verb rune, summary string, plain any, name string) {
+	if verb == 'v' && f.Flag('#') {
+		s := fmt.Sprintf(fmt.FormatString(f, verb), plain)
+		fmt.Fprint(f, strings.Replace(s, "telegram.plain", "telegram."+name, 1))
+		return
+	}
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, fmt.FormatString(f, verb), plain)
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), summary)
+}
+
+func fullName(first, last string) string {
+	if last == "" {
+		return first
+	}
+	return first + " " + last
+}
+
+// Cuts s to at most n runes, adding an ellipsis if something was cut
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
@@ -0,0 +1,77 @@
+/* format_test.go : tests for the readable output for logs
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	user := User{ID: 7, FirstName: "John", Username: "john"}
+	chat := Chat{ID: -1001234567890, Type: "supergroup", Title: "Gophers"}
+	message := Message{MessageID: 42, Chat: chat, From: &user, Text: "hello"}
+	update := Update{UpdateID: 1001, Message: &message}
+
+	tests := []struct {
+		name   string
+		format string
+		value  any
+		want   string
+	}{
+		{name: "user %v", format: "%v", value: user, want: "@john (7)"},
+		{name: "user pointer %v", format: "%v", value: &user, want: "@john (7)"},
+		{name: "user %s", format: "%s", value: user, want: "@john (7)"},
+		{name: "user without username", format: "%v", value: User{ID: 8, FirstName: "Ann", LastName: "Rossi"}, want: "Ann Rossi (8)"},
+		{name: "chat %v", format: "%v", value: chat, want: `supergroup "Gophers" (-1001234567890)`},
+		{name: "private chat %v", format: "%v", value: &Chat{ID: 7, Type: "private", FirstName: "John"}, want: "private John (7)"},
+		{name: "message %v", format: "%v", value: message, want: `#42 in supergroup "Gophers" (-1001234567890) from @john (7): "hello"`},
+		{name: "message pointer %v", format: "%v", value: &message, want: `#42 in supergroup "Gophers" (-1001234567890) from @john (7): "hello"`},
+		{name: "message %s", format: "%s", value: message, want: `#42 in supergroup "Gophers" (-1001234567890) from @john (7): "hello"`},
+		{
+			name:   "long message",
+			format: "%v",
+			value:  Message{MessageID: 1, Chat: Chat{ID: 7, Type: "private", FirstName: "John"}, Text: strings.Repeat("😀", formatTextLength+1)},
+			want:   `#1 in private John (7): "` + strings.Repeat("😀", formatTextLength) + `…"`,
+		},
+		{name: "update %v", format: "%v", value: update, want: "update 1001 (message, chat -1001234567890, user 7)"},
+		{name: "update pointer %v", format: "%v", value: &update, want: "update 1001 (message, chat -1001234567890, user 7)"},
+		{name: "unknown update %v", format: "%v", value: Update{UpdateID: 3}, want: "update 3 (unknown)"},
+		{name: "user %+v", format: "%+v", value: user, want: "{ID:7 IsBot:false FirstName:John"},
+		{name: "user %#v", format: "%#v", value: user, want: `telegram.User{ID:7, IsBot:false, FirstName:"John"`},
+		{name: "chat %#v", format: "%#v", value: chat, want: `telegram.Chat{ID:-1001234567890, Type:"supergroup"`},
+		{name: "message %+v", format: "%+v", value: message, want: "{MessageID:42 "},
+		{name: "message %#v", format: "%#v", value: message, want: "telegram.Message{MessageID:42, "},
+		{name: "update %#v", format: "%#v", value: update, want: "telegram.Update{UpdateID:1001, "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fmt.Sprintf(tt.format, tt.value)
+			if strings.HasPrefix(tt.format, "%+") || strings.HasPrefix(tt.format, "%#") {
+				if !strings.HasPrefix(got, tt.want) {
+					t.Errorf("got %s, want it to start with %s", got, tt.want)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// %+v and %#v must show the fields of the nested types too, not their summaries
+func TestFormatNestedFields(t *testing.T) {
+	message := Message{MessageID: 42, Chat: Chat{ID: 7, Type: "private", FirstName: "John"}}
+	if got := fmt.Sprintf("%+v", message); !strings.Contains(got, "Chat:{ID:7 Type:private") {
+		t.Errorf("%%+v = %s", got)
+	}
+	if got := fmt.Sprintf("%#v", message); !strings.Contains(got, `Chat:telegram.Chat{ID:7, Type:"private"`) {
+		t.Errorf("%%#v = %s", got)
+	}
+}
@@ -0,0 +1,83 @@
+/* forum.go : forum topics
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"unicode/utf8"
+)
+
+// The color of the icon of a forum topic, in RGB format. Telegram accepts only the colors of the palette below
+type ForumIconColor int64
+
+// The palette of the forum topic icons
+const (
+	ForumIconBlue   ForumIconColor = 0x6FB9F0
+	ForumIconYellow ForumIconColor = 0xFFD67E
+	ForumIconViolet ForumIconColor = 0xCB86DB
+	ForumIconGreen  ForumIconColor = 0x8EEE98
+	ForumIconRose   ForumIconColor = 0xFF93B2
+	ForumIconRed    ForumIconColor = 0xFB6F5F
+)
+
+// Maximum length of the name of a forum topic, in characters
+const MaxForumTopicNameLength = 128
+
+// Valid reports whether the color is one of the palette
+func (c ForumIconColor) Valid() bool {
+	switch c {
+	case ForumIconBlue, ForumIconYellow, ForumIconViolet, ForumIconGreen, ForumIconRose, ForumIconRed:
+		return true
+	}
+	return false
+}
+
+// Validate checks the parameters of createForumTopic. The custom emoji can't be checked here:
+// use ForumTopicIconStickers to get the allowed ones
+func (p *CreateForumTopicParams) Validate() error {
+	if err := validateSupergroup(p.ChatID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if n := utf8.RuneCountInString(p.Name); n < 1 || n > MaxForumTopicNameLength {
+		return fmt.Errorf("telegram: topic name must be 1-%d characters, got %d", MaxForumTopicNameLength, n)
+	}
+	if p.IconColor != 0 && !p.IconColor.Valid() {
+		return fmt.Errorf("telegram: icon_color %#06x is not one of the ForumIconColor palette", int64(p.IconColor))
+	}
+	return nil
+}
+
+// ForumTopicIconStickers calls getForumTopicIconStickers and returns the custom emoji identifiers
+// that can be used as IconCustomEmojiID, along with the stickers themselves (to show them to a user)
+func ForumTopicIconStickers(ctx context.Context, client *http.Client, token string) ([]string, []Sticker, error) {
+	stickers, err := Call[[]Sticker](ctx, client, token, "getForumTopicIconStickers", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := make([]string, 0, len(stickers))
+	for _, s := range stickers {
+		if s.CustomEmojiID != "" {
+			ids = append(ids, s.CustomEmojiID)
+		}
+	}
+	return ids, stickers, nil
+}
+
+// CreateForumTopic calls createForumTopic and returns the new topic: its MessageThreadID is what
+// the send methods want to post in it
+func CreateForumTopic(ctx context.Context, client *http.Client, token string, params CreateForumTopicParams) (*ForumTopic, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	topic, err := Call[ForumTopic](ctx, client, token, "createForumTopic", &params)
+	if err != nil {
+		return nil, err
+	}
+	return &topic, nil
+}
@@ -0,0 +1,91 @@
+/* forum_test.go : tests for forum topics
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCreateForumTopicValidate(t *testing.T) {
+	forum := NewChatID(-1001234567890)
+	tests := []struct {
+		name    string
+		params  CreateForumTopicParams
+		wantErr bool
+	}{
+		{name: "name only", params: CreateForumTopicParams{ChatID: forum, Name: "Support"}},
+		{name: "palette color", params: CreateForumTopicParams{ChatID: forum, Name: "Support", IconColor: ForumIconGreen}},
+		{name: "custom emoji", params: CreateForumTopicParams{ChatID: forum, Name: "Support", IconCustomEmojiID: "5312536423851630001"}},
+		{name: "username", params: CreateForumTopicParams{ChatID: NewChatUsername("forum"), Name: "Support"}},
+		{name: "longest name", params: CreateForumTopicParams{ChatID: forum, Name: strings.Repeat("n", MaxForumTopicNameLength)}},
+		{name: "color outside the palette", params: CreateForumTopicParams{ChatID: forum, Name: "Support", IconColor: 0x123456}, wantErr: true},
+		{name: "no name", params: CreateForumTopicParams{ChatID: forum}, wantErr: true},
+		{name: "name too long", params: CreateForumTopicParams{ChatID: forum, Name: strings.Repeat("n", MaxForumTopicNameLength+1)}, wantErr: true},
+		{name: "basic group", params: CreateForumTopicParams{ChatID: NewChatID(-12345), Name: "Support"}, wantErr: true},
+		{name: "no chat", params: CreateForumTopicParams{Name: "Support"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestForumIconColorValid(t *testing.T) {
+	for _, c := range []ForumIconColor{ForumIconBlue, ForumIconYellow, ForumIconViolet, ForumIconGreen, ForumIconRose, ForumIconRed} {
+		if !c.Valid() {
+			t.Errorf("%#06x is not valid", int64(c))
+		}
+	}
+	for _, c := range []ForumIconColor{0, 0xFFFFFF, ForumIconBlue + 1} {
+		if c.Valid() {
+			t.Errorf("%#06x is valid", int64(c))
+		}
+	}
+}
+
+func TestCreateForumTopic(t *testing.T) {
+	var gotMethod, gotParams string
+	client := fakeClient(func(method string, params []byte) string {
+		gotMethod, gotParams = method, string(params)
+		return `{"ok":true,"result":{"message_thread_id":77,"name":"Support","icon_color":9367192}}`
+	})
+	topic, err := CreateForumTopic(context.Background(), client, "123:abc", CreateForumTopicParams{ChatID: NewChatID(-1001234567890), Name: "Support", IconColor: ForumIconGreen})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if topic.MessageThreadID != 77 || topic.Name != "Support" || topic.IconColor != ForumIconGreen {
+		t.Errorf("CreateForumTopic() = %+v", topic)
+	}
+	if want := `{"chat_id":-1001234567890,"name":"Support","icon_color":9367192}`; gotMethod != "createForumTopic" || gotParams != want {
+		t.Errorf("called %s with %s, want %s", gotMethod, gotParams, want)
+	}
+}
+
+func TestForumTopicIconStickers(t *testing.T) {
+	client := fakeClient(func(method string, params []byte) string {
+		if method != "getForumTopicIconStickers" {
+			t.Errorf("method = %s", method)
+		}
+		return `{"ok":true,"result":[
+			{"file_id":"f1","file_unique_id":"u1","type":"custom_emoji","width":100,"height":100,"is_animated":false,"is_video":false,"custom_emoji_id":"111"},
+			{"file_id":"f2","file_unique_id":"u2","type":"custom_emoji","width":100,"height":100,"is_animated":false,"is_video":false,"custom_emoji_id":"222"}
+		]}`
+	})
+	ids, stickers, err := ForumTopicIconStickers(context.Background(), client, "123:abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(ids, []string{"111", "222"}) || len(stickers) != 2 {
+		t.Errorf("ForumTopicIconStickers() = %v, %d stickers", ids, len(stickers))
+	}
+}
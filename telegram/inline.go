@@ -0,0 +1,223 @@
+/* inline.go : answering inline queries
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Limits of answerInlineQuery
+const (
+	MaxInlineResults        = 50
+	MaxInlineResultIDLength = 64 // bytes
+	MaxInlineOffsetLength   = 64 // bytes
+)
+
+// ArticleBuilder builds an InlineQueryResultArticle one optional field at a time:
+//
+//	NewArticle("1", "Hello", "Hello, world!").Description("Greets everybody").Build()
+type ArticleBuilder struct {
+	article InlineQueryResultArticle
+	content InputTextMessageContent
+}
+
+// NewArticle starts an article result that sends text when chosen
+func NewArticle(id, title, text string) *ArticleBuilder {
+	return &ArticleBuilder{
+		article: InlineQueryResultArticle{ID: id, Title: title},
+		content: InputTextMessageContent{MessageText: text},
+	}
+}
+
+// Description sets the short description shown under the title
+func (b *ArticleBuilder) Description(description string) *ArticleBuilder {
+	b.article.Description = description
+	return b
+}
+
+// ThumbURL sets the URL of the thumbnail of the result
+func (b *ArticleBuilder) ThumbURL(url string) *ArticleBuilder {
+	b.article.ThumbnailURL = url
+	return b
+}
+
+// URL sets the URL of the result
+func (b *ArticleBuilder) URL(url string) *ArticleBuilder {
+	b.article.URL = url
+	return b
+}
+
+// ParseMode sets the parse mode of the text sent
+func (b *ArticleBuilder) ParseMode(mode ParseMode) *ArticleBuilder {
+	b.content.ParseMode = mode
+	return b
+}
+
+// Entities sets the entities of the text sent, e.g. the ones of a TextBuilder
+func (b *ArticleBuilder) Entities(entities []MessageEntity) *ArticleBuilder {
+	b.content.Entities = entities
+	return b
+}
+
+// ReplyMarkup sets the inline keyboard of the message sent
+func (b *ArticleBuilder) ReplyMarkup(markup *InlineKeyboardMarkup) *ArticleBuilder {
+	b.article.ReplyMarkup = markup
+	return b
+}
+
+// Build returns the result. The builder can be used again afterwards, the result doesn't change
+func (b *ArticleBuilder) Build() InlineQueryResult {
+	article := b.article
+	content := b.content
+	article.InputMessageContent = InputMessageContent{Text: &content}
+	return InlineQueryResult{Article: &article}
+}
+
+// ID returns the identifier of whichever member of the union is set
+func (r *InlineQueryResult) ID() string {
+	if r.Article != nil {
+		return r.Article.ID
+	}
+	return ""
+}
+
+// Validate checks the parameters of answerInlineQuery: the number of results, and that their
+// identifiers are 1-64 bytes long and unique
+func (p *AnswerInlineQueryParams) Validate() error {
+	if p.InlineQueryID == "" {
+		return fmt.Errorf("telegram: inline_query_id is required")
+	}
+	if len(p.Results) > MaxInlineResults {
+		return fmt.Errorf("telegram: %d inline results, the limit is %d", len(p.Results), MaxInlineResults)
+	}
+	if len(p.NextOffset) > MaxInlineOffsetLength {
+		return fmt.Errorf("telegram: next_offset is %d bytes long, the limit is %d", len(p.NextOffset), MaxInlineOffsetLength)
+	}
+	if p.CacheTime != nil && *p.CacheTime < 0 {
+		return fmt.Errorf("telegram: negative cache_time %d", *p.CacheTime)
+	}
+
+	if p.Button != nil {
+		if err := p.Button.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool, len(p.Results))
+	for i := range p.Results {
+		if err := p.Results[i].validate(); err != nil {
+			return fmt.Errorf("telegram: inline result %d: %w", i, err)
+		}
+		id := p.Results[i].ID()
+		if seen[id] {
+			return fmt.Errorf("telegram: inline result %d: duplicate id %q", i, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func (r *InlineQueryResult) validate() error {
+	if r.Article == nil {
+		return fmt.Errorf("no result set")
+	}
+	a := r.Article
+	if len(a.ID) == 0 || len(a.ID) > MaxInlineResultIDLength {
+		return fmt.Errorf("id is %d bytes long, it must be 1-%d", len(a.ID), MaxInlineResultIDLength)
+	}
+	if a.Title == "" {
+		return fmt.Errorf("article %q has no title", a.ID)
+	}
+	content := a.InputMessageContent.Text
+	if content == nil {
+		return fmt.Errorf("article %q has no content", a.ID)
+	}
+	if err := content.ParseMode.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(content.MessageText) == "" {
+		return fmt.Errorf("article %q: message text is empty", a.ID)
+	}
+	return checkLength("message text", content.MessageText, content.ParseMode, MaxMessageTextLength)
+}
+
+// SwitchPMButton returns the button that opens a private chat with the bot and sends it
+// "/start <parameter>", what switch_pm_text and switch_pm_parameter used to do before
+// they were deprecated in favour of the button field of answerInlineQuery.
+// Typically used to ask the user to log in or to set the bot up before using it inline
+func SwitchPMButton(text, parameter string) *InlineQueryResultsButton {
+	return &InlineQueryResultsButton{Text: text, StartParameter: parameter}
+}
+
+// WebAppButton returns the button that launches a Web App
+func WebAppButton(text, url string) *InlineQueryResultsButton {
+	return &InlineQueryResultsButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// Validate checks that the button has a text and exactly one of web_app and start_parameter,
+// with a valid parameter
+func (b *InlineQueryResultsButton) Validate() error {
+	if b.Text == "" {
+		return fmt.Errorf("telegram: inline results button has no text")
+	}
+	if (b.WebApp == nil) == (b.StartParameter == "") {
+		return fmt.Errorf("telegram: inline results button %q needs exactly one of web_app and start_parameter", b.Text)
+	}
+	if b.WebApp != nil {
+		if !strings.HasPrefix(b.WebApp.URL, "https://") {
+			return fmt.Errorf("telegram: inline results button %q: web app url must be https, got %q", b.Text, b.WebApp.URL)
+		}
+		return nil
+	}
+	return validateDeepLinkPayload(b.StartParameter)
+}
+
+// NewInlineAnswer returns the answer to query with one page of results: the ones starting at the
+// offset of the query, at most MaxInlineResults. NextOffset is set to the offset of the next page,
+// which the client sends back when the user scrolls, or left empty on the last page.
+// An offset that isn't a number (e.g. one not set by this function) starts from the first page
+func NewInlineAnswer(query *InlineQuery, results []InlineQueryResult) AnswerInlineQueryParams {
+	start, err := strconv.Atoi(query.Offset)
+	if err != nil || start < 0 || start > len(results) {
+		start = 0
+	}
+	end := min(start+MaxInlineResults, len(results))
+
+	params := AnswerInlineQueryParams{
+		InlineQueryID: query.ID,
+		Results:       results[start:end:end],
+	}
+	if params.Results == nil {
+		params.Results = []InlineQueryResult{} // Telegram wants an array even without results
+	}
+	if end < len(results) {
+		params.NextOffset = strconv.Itoa(end)
+	}
+	return params
+}
+
+// AnswerInlineQuery calls answerInlineQuery
+func AnswerInlineQuery(ctx context.Context, client *http.Client, token string, params AnswerInlineQueryParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "answerInlineQuery", &params)
+	return err
+}
+
+// AnswerInline answers query with the page of results asked by its offset (see NewInlineAnswer),
+// letting the answer be cached for cacheTime seconds, only for the user that sent it if isPersonal
+func AnswerInline(ctx context.Context, client *http.Client, token string, query *InlineQuery, results []InlineQueryResult, cacheTime int64, isPersonal bool) error {
+	params := NewInlineAnswer(query, results)
+	params.CacheTime = &cacheTime
+	params.IsPersonal = isPersonal
+	return AnswerInlineQuery(ctx, client, token, params)
+}
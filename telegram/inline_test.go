@@ -0,0 +1,218 @@
+/* inline_test.go : tests for answering inline queries
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Articles with ids "0", "1", ..., n-1
+func articles(n int) []InlineQueryResult {
+	results := make([]InlineQueryResult, n)
+	for i := range results {
+		id := strconv.Itoa(i)
+		results[i] = NewArticle(id, "Result "+id, "Text "+id).Build()
+	}
+	return results
+}
+
+func ExampleNewArticle() {
+	result := NewArticle("1", "Hello", "Hello, *world*").
+		Description("Greets everybody").
+		ParseMode(ParseModeMarkdownV2).
+		Build()
+	data, _ := json.Marshal(result)
+	fmt.Println(string(data))
+	// Output:
+	// {"type":"article","id":"1","title":"Hello","input_message_content":{"message_text":"Hello, *world*","parse_mode":"MarkdownV2"},"description":"Greets everybody"}
+}
+
+func TestArticleBuilderReuse(t *testing.T) {
+	b := NewArticle("1", "Hello", "Hello").Description("first")
+	first := b.Build()
+	b.Description("second").ParseMode(ParseModeHTML)
+	second := b.Build()
+	if first.Article.Description != "first" || first.Article.InputMessageContent.Text.ParseMode != "" {
+		t.Errorf("the first result changed after Build: %+v", first.Article)
+	}
+	if second.Article.Description != "second" || second.Article.InputMessageContent.Text.ParseMode != ParseModeHTML {
+		t.Errorf("second result = %+v", second.Article)
+	}
+}
+
+func TestAnswerInlineQueryValidate(t *testing.T) {
+	longID := strings.Repeat("x", MaxInlineResultIDLength)
+	tests := []struct {
+		name    string
+		params  AnswerInlineQueryParams
+		wantErr bool
+	}{
+		{name: "no results", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: []InlineQueryResult{}}},
+		{name: "50 results", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: articles(MaxInlineResults)}},
+		{name: "51 results", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: articles(MaxInlineResults + 1)}, wantErr: true},
+		{name: "64 byte id", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: []InlineQueryResult{NewArticle(longID, "t", "x").Build()}}},
+		{name: "65 byte id", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: []InlineQueryResult{NewArticle(longID+"x", "t", "x").Build()}}, wantErr: true},
+		// 33 two-byte runes: 33 characters, but 66 bytes
+		{name: "id counted in bytes", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: []InlineQueryResult{NewArticle(strings.Repeat("é", 33), "t", "x").Build()}}, wantErr: true},
+		{name: "empty id", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: []InlineQueryResult{NewArticle("", "t", "x").Build()}}, wantErr: true},
+		{name: "duplicate id", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: append(articles(2), articles(1)...)}, wantErr: true},
+		{name: "no title", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: []InlineQueryResult{NewArticle("1", "", "x").Build()}}, wantErr: true},
+		{name: "empty text", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: []InlineQueryResult{NewArticle("1", "t", " ").Build()}}, wantErr: true},
+		{name: "no query", params: AnswerInlineQueryParams{Results: articles(1)}, wantErr: true},
+		{name: "offset too long", params: AnswerInlineQueryParams{InlineQueryID: "q", Results: articles(1), NextOffset: strings.Repeat("9", MaxInlineOffsetLength+1)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewInlineAnswer(t *testing.T) {
+	results := articles(120)
+	tests := []struct {
+		name           string
+		offset         string
+		wantFirst      string
+		wantLen        int
+		wantNextOffset string
+	}{
+		{name: "first page", offset: "", wantFirst: "0", wantLen: 50, wantNextOffset: "50"},
+		{name: "second page", offset: "50", wantFirst: "50", wantLen: 50, wantNextOffset: "100"},
+		{name: "last page", offset: "100", wantFirst: "100", wantLen: 20},
+		{name: "past the end", offset: "200", wantFirst: "0", wantLen: 50, wantNextOffset: "50"},
+		{name: "not a number", offset: "page2", wantFirst: "0", wantLen: 50, wantNextOffset: "50"},
+		{name: "negative", offset: "-5", wantFirst: "0", wantLen: 50, wantNextOffset: "50"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := NewInlineAnswer(&InlineQuery{ID: "q", Offset: tt.offset}, results)
+			if len(params.Results) != tt.wantLen || params.Results[0].ID() != tt.wantFirst {
+				t.Errorf("%d results from %q, want %d from %q", len(params.Results), params.Results[0].ID(), tt.wantLen, tt.wantFirst)
+			}
+			if params.NextOffset != tt.wantNextOffset {
+				t.Errorf("NextOffset = %q, want %q", params.NextOffset, tt.wantNextOffset)
+			}
+			if err := params.Validate(); err != nil {
+				t.Errorf("the page isn't valid: %v", err)
+			}
+		})
+	}
+
+	t.Run("no results", func(t *testing.T) {
+		params := NewInlineAnswer(&InlineQuery{ID: "q"}, nil)
+		data, err := json.Marshal(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), `"results":[]`) {
+			t.Errorf("body %s, want an empty array of results", data)
+		}
+	})
+}
+
+// Answering an inline query: the page asked for, with the caching options
+func TestAnswerInline(t *testing.T) {
+	var gotMethod string
+	var body struct {
+		InlineQueryID string            `json:"inline_query_id"`
+		Results       []json.RawMessage `json:"results"`
+		CacheTime     *int64            `json:"cache_time"`
+		IsPersonal    bool              `json:"is_personal"`
+		NextOffset    string            `json:"next_offset"`
+	}
+	client := fakeClient(func(method string, params []byte) string {
+		gotMethod = method
+		if err := json.Unmarshal(params, &body); err != nil {
+			t.Error(err)
+		}
+		return `{"ok":true,"result":true}`
+	})
+
+	query := &InlineQuery{ID: "4242", From: User{ID: 7}, Query: "res", Offset: "50"}
+	if err := AnswerInline(context.Background(), client, "123:abc", query, articles(120), 0, true); err != nil {
+		t.Fatal(err)
+	}
+	if gotMethod != "answerInlineQuery" {
+		t.Errorf("called %s, want answerInlineQuery", gotMethod)
+	}
+	if body.InlineQueryID != "4242" || len(body.Results) != 50 || body.NextOffset != "100" {
+		t.Errorf("answered %q with %d results and next offset %q", body.InlineQueryID, len(body.Results), body.NextOffset)
+	}
+	// A cache_time of 0 must be sent, not left to Telegram's default of 300
+	if body.CacheTime == nil || *body.CacheTime != 0 || !body.IsPersonal {
+		t.Errorf("cache_time = %v, is_personal = %v, want 0 and true", body.CacheTime, body.IsPersonal)
+	}
+
+	// Invalid results are refused before calling Telegram
+	gotMethod = ""
+	bad := []InlineQueryResult{NewArticle(strings.Repeat("x", MaxInlineResultIDLength+1), "t", "x").Build()}
+	if err := AnswerInline(context.Background(), client, "123:abc", query, bad, 0, false); err == nil || gotMethod != "" {
+		t.Errorf("AnswerInline() = %v after calling %q, want an error without calls", err, gotMethod)
+	}
+}
+
+func TestInlineQueryResultsButton(t *testing.T) {
+	tests := []struct {
+		name     string
+		button   *InlineQueryResultsButton
+		wantJSON string
+		wantErr  bool
+	}{
+		{
+			name:     "switch to private chat",
+			button:   SwitchPMButton("Log in", "login_42"),
+			wantJSON: `{"text":"Log in","start_parameter":"login_42"}`,
+		},
+		{
+			name:     "web app",
+			button:   WebAppButton("Open", "https://example.com/app"),
+			wantJSON: `{"text":"Open","web_app":{"url":"https://example.com/app"}}`,
+		},
+		{name: "no text", button: SwitchPMButton("", "login"), wantErr: true},
+		{name: "parameter with spaces", button: SwitchPMButton("Log in", "log in"), wantErr: true},
+		{name: "parameter too long", button: SwitchPMButton("Log in", strings.Repeat("a", 65)), wantErr: true},
+		{name: "web app over http", button: WebAppButton("Open", "http://example.com/app"), wantErr: true},
+		{name: "neither", button: &InlineQueryResultsButton{Text: "Nothing"}, wantErr: true},
+		{name: "both", button: &InlineQueryResultsButton{Text: "Both", StartParameter: "a", WebApp: &WebAppInfo{URL: "https://example.com"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := AnswerInlineQueryParams{InlineQueryID: "q", Results: []InlineQueryResult{}, Button: tt.button}
+			if err := params.Validate(); (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			data, err := json.Marshal(params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var body struct {
+				Button json.RawMessage `json:"button"`
+			}
+			if err := json.Unmarshal(data, &body); err != nil {
+				t.Fatal(err)
+			}
+			if string(body.Button) != tt.wantJSON {
+				t.Errorf("button = %s, want %s", body.Button, tt.wantJSON)
+			}
+			// The deprecated fields are never sent: the button replaces them
+			if strings.Contains(string(data), "switch_pm") {
+				t.Errorf("body %s has switch_pm fields", data)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/* interceptor.go : hooks around the calls to the Bot API
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * WithHeaders works on HTTP requests. A RequestInterceptor works one level
+ * up, on the calls to the methods: it sees the name of the method and its
+ * parameters, can change them, record them, or answer without calling
+ * Telegram at all. Logging, retries, rate limiting and caches are all
+ * interceptors. Like WithHeaders they are set on the *http.Client:
+ *
+ *	client := telegram.WithRequestInterceptor(nil, logCalls)
+ *	client = telegram.WithRequestInterceptor(client, retryOn429)
+ *
+ * The interceptor added last runs first: here retryOn429 sees every call
+ * before logCalls, and logCalls sees each retry. Interceptors always run
+ * before the transport of the client they wrap, so headers set by an
+ * inner WithHeaders replace the ones set by an interceptor.
+ */
+
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// APIRequest is a call to a method of the Bot API, as seen by a RequestInterceptor
+type APIRequest struct {
+	// Name of the method, e.g. "sendMessage"
+	Method string
+
+	// The encoded parameters: JSON, or multipart/form-data for uploads, see ContentType
+	Body []byte
+
+	// The headers of the HTTP request, Content-Type and User-Agent included
+	Header http.Header
+}
+
+// ContentType returns the Content-Type of the body
+func (r *APIRequest) ContentType() string {
+	return r.Header.Get("Content-Type")
+}
+
+// RoundTrip sends a call to the Bot API and returns the body of the answer.
+// Failures reported by Telegram are in the body: the error is for the ones of the network
+type RoundTrip func(ctx context.Context, req *APIRequest) ([]byte, error)
+
+// RequestInterceptor wraps the RoundTrip that sends the calls. It can change req before calling next,
+// look at the answer after, or not call next at all and return an answer of its own
+type RequestInterceptor func(next RoundTrip) RoundTrip
+
+// WithRequestInterceptor returns a copy of client whose calls to the Bot API go through interceptor.
+// A nil client means http.DefaultClient. File downloads (the /file/ paths) are not intercepted.
+// The status of the HTTP answer is dropped, the library only looks at its JSON body
+func WithRequestInterceptor(client *http.Client, interceptor RequestInterceptor) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	c := *client
+	c.Transport = &interceptTransport{base: client.Transport, interceptor: interceptor}
+	return &c
+}
+
+// A RoundTripper that passes the calls to the Bot API through an interceptor before base
+type interceptTransport struct {
+	base        http.RoundTripper
+	interceptor RequestInterceptor
+}
+
+func (t *interceptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if !strings.HasPrefix(req.URL.Path, "/bot") {
+		return base.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	call := &APIRequest{Method: path.Base(req.URL.Path), Body: body, Header: req.Header.Clone()}
+
+	send := func(ctx context.Context, call *APIRequest) ([]byte, error) {
+		// A RoundTripper must not modify the request it is given
+		out := req.Clone(ctx)
+		out.URL.Path = path.Join(path.Dir(req.URL.Path), call.Method)
+		out.URL.RawPath = ""
+		out.Header = call.Header
+		out.Body = io.NopCloser(bytes.NewReader(call.Body))
+		out.ContentLength = int64(len(call.Body))
+		resp, err := base.RoundTrip(out)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		return io.ReadAll(resp.Body)
+	}
+
+	data, err := t.interceptor(send)(req.Context(), call)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode:    http.StatusOK,
+		Status:        "200 OK",
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,132 @@
+/* interceptor_test.go : tests for the request interceptors
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRequestInterceptorObserves(t *testing.T) {
+	var sent []string
+	client := fakeClient(func(method string, params []byte) string {
+		sent = append(sent, method+" "+string(params))
+		return `{"ok":true,"result":{"id":42,"is_bot":true,"first_name":"Bot"}}`
+	})
+
+	var seen []string
+	client = WithRequestInterceptor(client, func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, req *APIRequest) ([]byte, error) {
+			seen = append(seen, req.Method+" "+string(req.Body)+" "+req.ContentType())
+			answer, err := next(ctx, req)
+			seen = append(seen, string(answer))
+			return answer, err
+		}
+	})
+
+	me, err := Call[User](context.Background(), client, "123:abc", "getMe", map[string]int{"n": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if me.ID != 42 {
+		t.Errorf("got %+v", me)
+	}
+	want := []string{`getMe {"n":1} application/json`, `{"ok":true,"result":{"id":42,"is_bot":true,"first_name":"Bot"}}`}
+	if !slices.Equal(seen, want) {
+		t.Errorf("interceptor saw %q, want %q", seen, want)
+	}
+	if !slices.Equal(sent, []string{`getMe {"n":1}`}) {
+		t.Errorf("sent %q", sent)
+	}
+}
+
+func TestRequestInterceptorShortCircuits(t *testing.T) {
+	client := fakeClient(func(method string, params []byte) string {
+		t.Errorf("%s reached Telegram", method)
+		return `{"ok":true,"result":true}`
+	})
+	client = WithRequestInterceptor(client, func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, req *APIRequest) ([]byte, error) {
+			if req.Method == "getChatMemberCount" {
+				return []byte(`{"ok":true,"result":57}`), nil
+			}
+			return next(ctx, req)
+		}
+	})
+	n, err := GetChatMemberCount(context.Background(), client, "123:abc", NewChatID(-100))
+	if err != nil || n != 57 {
+		t.Errorf("GetChatMemberCount() = %d, %v", n, err)
+	}
+}
+
+func TestRequestInterceptorChanges(t *testing.T) {
+	var gotMethod, gotParams, gotHeader string
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		params, _ := io.ReadAll(req.Body)
+		gotMethod, gotParams, gotHeader = req.URL.Path, string(params), req.Header.Get("X-Trace")
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"ok":true,"result":true}`)), Request: req}, nil
+	})}
+	client = WithRequestInterceptor(client, func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, req *APIRequest) ([]byte, error) {
+			req.Header.Set("X-Trace", "abc")
+			req.Body = []byte(`{"chat_id":-100,"title":"Changed"}`)
+			return next(ctx, req)
+		}
+	})
+	if err := SetChatTitle(context.Background(), client, "123:abc", SetChatTitleParams{ChatID: NewChatID(-100), Title: "Title"}); err != nil {
+		t.Fatal(err)
+	}
+	if gotMethod != "/bot123:abc/setChatTitle" || gotParams != `{"chat_id":-100,"title":"Changed"}` || gotHeader != "abc" {
+		t.Errorf("sent %s %s with X-Trace %q", gotMethod, gotParams, gotHeader)
+	}
+}
+
+func TestRequestInterceptorOrder(t *testing.T) {
+	var order []string
+	named := func(name string) RequestInterceptor {
+		return func(next RoundTrip) RoundTrip {
+			return func(ctx context.Context, req *APIRequest) ([]byte, error) {
+				order = append(order, name)
+				return next(ctx, req)
+			}
+		}
+	}
+	client := fakeClient(func(method string, params []byte) string {
+		order = append(order, "telegram")
+		return `{"ok":true,"result":true}`
+	})
+	client = WithRequestInterceptor(client, named("inner"))
+	client = WithRequestInterceptor(client, named("outer"))
+	if _, err := callBool(context.Background(), client, "123:abc", "close", nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"outer", "inner", "telegram"}; !slices.Equal(order, want) {
+		t.Errorf("order %q, want %q", order, want)
+	}
+}
+
+func TestRequestInterceptorSkipsDownloads(t *testing.T) {
+	client := fakeFileServer(`{"file_id":"f1","file_unique_id":"u1","file_path":"a.txt"}`, http.StatusOK, "hello")
+	var methods []string
+	client = WithRequestInterceptor(client, func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, req *APIRequest) ([]byte, error) {
+			methods = append(methods, req.Method)
+			return next(ctx, req)
+		}
+	})
+	dest := t.TempDir() + "/a.txt"
+	if err := SaveFileByID(context.Background(), client, "123:abc", "f1", dest); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(methods, []string{"getFile"}) {
+		t.Errorf("intercepted %q, want only getFile", methods)
+	}
+}
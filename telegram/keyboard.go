@@ -0,0 +1,147 @@
+/* keyboard.go : keyboards and buttons
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
+// RemoveInlineKeyboard returns the reply markup that removes the inline keyboard of a message when editing it,
+// sent as {"inline_keyboard":[]}. An edit without reply_markup (a nil ReplyMarkup) removes the keyboard too:
+// there is no way to leave it alone, so editMessageText and editMessageCaption must send the keyboard
+// again to keep it. RemoveInlineKeyboard just makes the removal explicit.
+// Each call returns a new markup, so adding rows to one doesn't change the others
+func RemoveInlineKeyboard() *InlineKeyboardMarkup {
+	return &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{}}
+}
+
+// MarshalJSON always sends inline_keyboard as an array: a markup without rows means "no keyboard",
+// and Telegram rejects null there
+func (m InlineKeyboardMarkup) MarshalJSON() ([]byte, error) {
+	type plain InlineKeyboardMarkup // same fields without this method
+	if m.InlineKeyboard == nil {
+		m.InlineKeyboard = [][]InlineKeyboardButton{}
+	}
+	return json.Marshal(plain(m))
+}
+
+// Validate checks that at most one kind of request is set on the button,
+// and that a poll request asks for a known type of poll
+func (b *KeyboardButton) Validate() error {
+	requests := 0
+	if b.RequestContact {
+		requests++
+	}
+	if b.RequestLocation {
+		requests++
+	}
+	if b.RequestPoll != nil {
+		requests++
+		switch b.RequestPoll.Type {
+		case "", "quiz", "regular":
+		default:
+			return fmt.Errorf("telegram: keyboard button %q: unknown poll type %q", b.Text, b.RequestPoll.Type)
+		}
+	}
+	if requests > 1 {
+		return fmt.Errorf("telegram: keyboard button %q: at most one of request_contact, request_location and request_poll can be set", b.Text)
+	}
+	return nil
+}
+
+// Validate checks every button of the keyboard
+func (k *ReplyKeyboardMarkup) Validate() error {
+	for _, row := range k.Keyboard {
+		for i := range row {
+			if err := row[i].Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// PaginatedKeyboard lays out one page of items in rows of cols buttons, showing perPage items per page,
+// and adds a row with ◀ and ▶ buttons to move to the previous and the next page (only the ones that exist).
+// Their callback data is PackCallbackData(navPrefix, page), so the handler of the navigation buttons gets
+// the page to show with UnpackCallbackData. A page out of range is clamped to the first or the last one
+func PaginatedKeyboard(items []InlineKeyboardButton, perPage, cols, page int, navPrefix string) (InlineKeyboardMarkup, error) {
+	if perPage < 1 || cols < 1 {
+		return InlineKeyboardMarkup{}, fmt.Errorf("telegram: perPage and cols must be positive, got %d and %d", perPage, cols)
+	}
+
+	pages := (len(items) + perPage - 1) / perPage
+	if pages == 0 {
+		pages = 1
+	}
+	page = max(0, min(page, pages-1))
+
+	start := page * perPage
+	end := min(start+perPage, len(items))
+	var rows [][]InlineKeyboardButton
+	for i := start; i < end; i += cols {
+		rows = append(rows, slices.Clone(items[i:min(i+cols, end)]))
+	}
+
+	var nav []InlineKeyboardButton
+	if page > 0 {
+		data, err := PackCallbackData(navPrefix, page-1)
+		if err != nil {
+			return InlineKeyboardMarkup{}, err
+		}
+		nav = append(nav, InlineKeyboardButton{Text: "◀", CallbackData: data})
+	}
+	if page < pages-1 {
+		data, err := PackCallbackData(navPrefix, page+1)
+		if err != nil {
+			return InlineKeyboardMarkup{}, err
+		}
+		nav = append(nav, InlineKeyboardButton{Text: "▶", CallbackData: data})
+	}
+	if len(nav) > 0 {
+		rows = append(rows, nav)
+	}
+	return InlineKeyboardMarkup{InlineKeyboard: rows}, nil
+}
+
+// Maximum length of the placeholder of the input field, for ReplyKeyboardMarkup and ForceReply
+const MaxInputFieldPlaceholderLength = 64
+
+// IsZero reports whether no member of the union is set, i.e. the message is sent without markup
+func (m ReplyMarkup) IsZero() bool {
+	return m.Inline == nil && m.Keyboard == nil && m.Remove == nil && m.ForceReply == nil
+}
+
+// At most one member can be set, and the reply keyboard must be valid
+func (m *ReplyMarkup) validate() error {
+	set := 0
+	for _, member := range []bool{m.Inline != nil, m.Keyboard != nil, m.Remove != nil, m.ForceReply != nil} {
+		if member {
+			set++
+		}
+	}
+	if set > 1 {
+		return fmt.Errorf("reply_markup can be only one of inline keyboard, reply keyboard, keyboard removal and force reply")
+	}
+	var placeholder string
+	switch {
+	case m.Keyboard != nil:
+		if err := m.Keyboard.Validate(); err != nil {
+			return err
+		}
+		placeholder = m.Keyboard.InputFieldPlaceholder
+	case m.ForceReply != nil:
+		placeholder = m.ForceReply.InputFieldPlaceholder
+	}
+	if n := utf8.RuneCountInString(placeholder); n > MaxInputFieldPlaceholderLength {
+		return fmt.Errorf("input field placeholder is %d characters long, the limit is %d", n, MaxInputFieldPlaceholderLength)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+/* keyboard_test.go : tests for the reply markup of the send methods
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestReplyMarkupJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		markup ReplyMarkup
+		want   string
+	}{
+		{
+			name:   "inline",
+			markup: ReplyMarkup{Inline: &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "ok", CallbackData: "ok"}}}}},
+			want:   `{"inline_keyboard":[[{"text":"ok","callback_data":"ok"}]]}`,
+		},
+		{
+			name:   "keyboard",
+			markup: ReplyMarkup{Keyboard: &ReplyKeyboardMarkup{Keyboard: [][]KeyboardButton{{{Text: "yes"}}}}},
+			want:   `{"keyboard":[[{"text":"yes"}]]}`,
+		},
+		{
+			name:   "remove",
+			markup: ReplyMarkup{Remove: &ReplyKeyboardRemove{Selective: true}},
+			want:   `{"remove_keyboard":true,"selective":true}`,
+		},
+		{
+			name:   "force reply",
+			markup: ReplyMarkup{ForceReply: &ForceReply{InputFieldPlaceholder: "your name"}},
+			want:   `{"force_reply":true,"input_field_placeholder":"your name"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.markup)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendParamsReplyMarkup(t *testing.T) {
+	params := SendMessageParams{Text: "hi"}
+	params.ChatID = ChatID{ID: 42}
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "reply_markup") {
+		t.Errorf("zero ReplyMarkup was sent: %s", data)
+	}
+
+	params.ReplyMarkup = ReplyMarkup{Remove: &ReplyKeyboardRemove{}}
+	data, err = json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"reply_markup":{"remove_keyboard":true}`) {
+		t.Errorf("ReplyMarkup missing: %s", data)
+	}
+}
+
+func TestReplyMarkupValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		markup  ReplyMarkup
+		wantErr bool
+	}{
+		{name: "zero", markup: ReplyMarkup{}},
+		{name: "one member", markup: ReplyMarkup{ForceReply: &ForceReply{}}},
+		{name: "two members", markup: ReplyMarkup{Remove: &ReplyKeyboardRemove{}, ForceReply: &ForceReply{}}, wantErr: true},
+		{name: "long placeholder", markup: ReplyMarkup{ForceReply: &ForceReply{InputFieldPlaceholder: strings.Repeat("😀", MaxInputFieldPlaceholderLength+1)}}, wantErr: true},
+		{name: "invalid button", markup: ReplyMarkup{Keyboard: &ReplyKeyboardMarkup{Keyboard: [][]KeyboardButton{{{Text: "me", RequestContact: true, RequestLocation: true}}}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.markup.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEditReplyMarkupJSON(t *testing.T) {
+	locator := MessageLocator{ChatID: ChatID{ID: 7}, MessageID: 3}
+	tests := []struct {
+		name   string
+		markup *InlineKeyboardMarkup
+		want   string
+	}{
+		{name: "omitted", markup: nil, want: `{"chat_id":7,"message_id":3,"text":"done"}`},
+		{name: "removed", markup: RemoveInlineKeyboard(), want: `{"chat_id":7,"message_id":3,"text":"done","reply_markup":{"inline_keyboard":[]}}`},
+		{name: "no rows", markup: &InlineKeyboardMarkup{}, want: `{"chat_id":7,"message_id":3,"text":"done","reply_markup":{"inline_keyboard":[]}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(EditMessageTextParams{MessageLocator: locator, Text: "done", ReplyMarkup: tt.markup})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveInlineKeyboardIsFresh(t *testing.T) {
+	first := RemoveInlineKeyboard()
+	first.InlineKeyboard = append(first.InlineKeyboard, []InlineKeyboardButton{{Text: "a", CallbackData: "a"}})
+	if second := RemoveInlineKeyboard(); second == first || len(second.InlineKeyboard) != 0 {
+		t.Errorf("RemoveInlineKeyboard() = %+v after changing the previous one", second)
+	}
+}
+
+func TestPaginatedKeyboard(t *testing.T) {
+	items := make([]InlineKeyboardButton, 7)
+	for i := range items {
+		items[i] = InlineKeyboardButton{Text: strconv.Itoa(i), CallbackData: "item:" + strconv.Itoa(i)}
+	}
+	tests := []struct {
+		name     string
+		items    []InlineKeyboardButton
+		perPage  int
+		cols     int
+		page     int
+		wantRows []string // texts of each row, the last one being the navigation row if any
+		wantPrev int      // page in the ◀ button, -1 if there is none
+		wantNext int      // page in the ▶ button, -1 if there is none
+	}{
+		{name: "first page", items: items, perPage: 3, cols: 2, page: 0, wantRows: []string{"0 1", "2", "▶"}, wantPrev: -1, wantNext: 1},
+		{name: "middle page", items: items, perPage: 3, cols: 2, page: 1, wantRows: []string{"3 4", "5", "◀ ▶"}, wantPrev: 0, wantNext: 2},
+		{name: "last page, not full", items: items, perPage: 3, cols: 2, page: 2, wantRows: []string{"6", "◀"}, wantPrev: 1, wantNext: -1},
+		{name: "page after the last", items: items, perPage: 3, cols: 2, page: 9, wantRows: []string{"6", "◀"}, wantPrev: 1, wantNext: -1},
+		{name: "negative page", items: items, perPage: 3, cols: 2, page: -1, wantRows: []string{"0 1", "2", "▶"}, wantPrev: -1, wantNext: 1},
+		{name: "one page", items: items[:4], perPage: 4, cols: 4, page: 0, wantRows: []string{"0 1 2 3"}, wantPrev: -1, wantNext: -1},
+		{name: "exact pages", items: items[:6], perPage: 3, cols: 3, page: 1, wantRows: []string{"3 4 5", "◀"}, wantPrev: 0, wantNext: -1},
+		{name: "no items", items: nil, perPage: 3, cols: 2, page: 0, wantRows: nil, wantPrev: -1, wantNext: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kb, err := PaginatedKeyboard(tt.items, tt.perPage, tt.cols, tt.page, "pg")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var rows []string
+			prev, next := -1, -1
+			for _, row := range kb.InlineKeyboard {
+				var texts []string
+				for _, b := range row {
+					texts = append(texts, b.Text)
+					var page int
+					if b.Text == "◀" || b.Text == "▶" {
+						prefix, err := UnpackCallbackData(b.CallbackData, &page)
+						if err != nil || prefix != "pg" {
+							t.Errorf("navigation data %q: prefix %q, %v", b.CallbackData, prefix, err)
+						}
+						if b.Text == "◀" {
+							prev = page
+						} else {
+							next = page
+						}
+					}
+				}
+				rows = append(rows, strings.Join(texts, " "))
+			}
+			if !slices.Equal(rows, tt.wantRows) {
+				t.Errorf("rows = %q, want %q", rows, tt.wantRows)
+			}
+			if prev != tt.wantPrev || next != tt.wantNext {
+				t.Errorf("navigation to %d and %d, want %d and %d", prev, next, tt.wantPrev, tt.wantNext)
+			}
+		})
+	}
+
+	if _, err := PaginatedKeyboard(items, 0, 2, 0, "pg"); err == nil {
+		t.Error("perPage 0 accepted")
+	}
+	if _, err := PaginatedKeyboard(items, 3, 2, 0, ""); err == nil {
+		t.Error("empty navigation prefix accepted")
+	}
+}
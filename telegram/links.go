@@ -0,0 +1,106 @@
+/* links.go : t.me links
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Maximum length of the payload of a deep link
+const MaxDeepLinkPayloadLength = 64
+
+// DeepLink returns the link that opens a private chat with the bot and sends it
+// "/start <payload>": https://t.me/<botUsername>?start=<payload>.
+// The payload can only contain A-Z, a-z, 0-9, _ and -, up to 64 characters:
+// base64url is a good way to fit arbitrary data in it
+func DeepLink(botUsername, payload string) (string, error) {
+	return deepLink(botUsername, "start", payload)
+}
+
+// GroupDeepLink returns the link that asks the user to add the bot to a group and then
+// sends "/start <payload>" there: https://t.me/<botUsername>?startgroup=<payload>
+func GroupDeepLink(botUsername, payload string) (string, error) {
+	return deepLink(botUsername, "startgroup", payload)
+}
+
+func deepLink(botUsername, parameter, payload string) (string, error) {
+	botUsername = strings.TrimPrefix(botUsername, "@")
+	if !isValidUsername(botUsername) {
+		return "", fmt.Errorf("telegram: invalid bot username %q", botUsername)
+	}
+	if err := validateDeepLinkPayload(payload); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://t.me/%s?%s=%s", botUsername, parameter, payload), nil
+}
+
+func validateDeepLinkPayload(payload string) error {
+	if len(payload) > MaxDeepLinkPayloadLength {
+		return fmt.Errorf("telegram: deep link payload is %d characters long, the limit is %d", len(payload), MaxDeepLinkPayloadLength)
+	}
+	for _, r := range payload {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+		default:
+			return fmt.Errorf("telegram: deep link payload can't contain %q", r)
+		}
+	}
+	return nil
+}
+
+// StartPayload returns the payload of a "/start <payload>" message, the one a user
+// sends by opening a deep link. The command may be addressed to the bot ("/start@my_bot").
+// The boolean is false if the message is not a /start command or has no payload
+func (m *Message) StartPayload() (string, bool) {
+	command, payload, _ := strings.Cut(m.Text, " ")
+	command, _, _ = strings.Cut(command, "@")
+	if command != "/start" {
+		return "", false
+	}
+	payload = strings.TrimSpace(payload)
+	return payload, payload != ""
+}
+
+// PermalinkPublic returns the public link of the message, https://t.me/<username>/<message_id>, with the
+// topic in between for the messages of a forum topic. The boolean is false if the chat is not a supergroup
+// or a channel with a username: only those have links that work for everybody
+func (m *Message) PermalinkPublic() (string, bool) {
+	if m.Chat.Username == "" || (m.Chat.Type != "supergroup" && m.Chat.Type != "channel") {
+		return "", false
+	}
+	return m.permalink(m.Chat.Username), true
+}
+
+// PermalinkPrivate returns the link of the message that works for the members of the chat,
+// https://t.me/c/<internal_id>/<message_id>, with the topic in between for the messages of a forum topic.
+// The internal identifier is the chat identifier without the -100 prefix. The boolean is false if the chat
+// is not a supergroup or a channel: messages of private chats and basic groups have no links
+func (m *Message) PermalinkPrivate() (string, bool) {
+	const prefix = -1000000000000
+	if m.Chat.ID > prefix || (m.Chat.Type != "supergroup" && m.Chat.Type != "channel") {
+		return "", false
+	}
+	return m.permalink("c/" + strconv.FormatInt(prefix-m.Chat.ID, 10)), true
+}
+
+// Permalink returns the public link of the message when there is one, and the link for the members of the
+// chat otherwise. The boolean is false if the message has no link at all
+func (m *Message) Permalink() (string, bool) {
+	if link, ok := m.PermalinkPublic(); ok {
+		return link, true
+	}
+	return m.PermalinkPrivate()
+}
+
+func (m *Message) permalink(chat string) string {
+	if topic, ok := m.TopicID(); ok {
+		return fmt.Sprintf("https://t.me/%s/%d/%d", chat, topic, m.MessageID)
+	}
+	return fmt.Sprintf("https://t.me/%s/%d", chat, m.MessageID)
+}
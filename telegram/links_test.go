@@ -0,0 +1,81 @@
+/* links_test.go : tests for t.me links
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import "testing"
+
+func TestPermalink(t *testing.T) {
+	channel := Chat{ID: -1001234567890, Type: "channel", Title: "News", Username: "news"}
+	privateChannel := Chat{ID: -1001234567890, Type: "channel", Title: "News"}
+	publicGroup := Chat{ID: -1009876543210, Type: "supergroup", Title: "Gophers", Username: "gophers"}
+	privateGroup := Chat{ID: -1009876543210, Type: "supergroup", Title: "Staff"}
+	tests := []struct {
+		name        string
+		msg         Message
+		wantPublic  string
+		wantPrivate string
+		wantAny     string
+	}{
+		{
+			name:        "public channel",
+			msg:         Message{MessageID: 42, Chat: channel},
+			wantPublic:  "https://t.me/news/42",
+			wantPrivate: "https://t.me/c/1234567890/42",
+			wantAny:     "https://t.me/news/42",
+		},
+		{
+			name:        "private channel",
+			msg:         Message{MessageID: 42, Chat: privateChannel},
+			wantPrivate: "https://t.me/c/1234567890/42",
+			wantAny:     "https://t.me/c/1234567890/42",
+		},
+		{
+			name:        "public supergroup",
+			msg:         Message{MessageID: 7, Chat: publicGroup},
+			wantPublic:  "https://t.me/gophers/7",
+			wantPrivate: "https://t.me/c/9876543210/7",
+			wantAny:     "https://t.me/gophers/7",
+		},
+		{
+			name:        "topic of a public supergroup",
+			msg:         Message{MessageID: 8, Chat: publicGroup, MessageThreadID: 3, IsTopicMessage: true},
+			wantPublic:  "https://t.me/gophers/3/8",
+			wantPrivate: "https://t.me/c/9876543210/3/8",
+			wantAny:     "https://t.me/gophers/3/8",
+		},
+		{
+			name:        "topic of a private supergroup",
+			msg:         Message{MessageID: 8, Chat: privateGroup, MessageThreadID: 3, IsTopicMessage: true},
+			wantPrivate: "https://t.me/c/9876543210/3/8",
+			wantAny:     "https://t.me/c/9876543210/3/8",
+		},
+		{
+			name:        "reply thread that is not a topic",
+			msg:         Message{MessageID: 8, Chat: publicGroup, MessageThreadID: 5},
+			wantPublic:  "https://t.me/gophers/8",
+			wantPrivate: "https://t.me/c/9876543210/8",
+			wantAny:     "https://t.me/gophers/8",
+		},
+		{name: "private chat", msg: Message{MessageID: 1, Chat: Chat{ID: 7, Type: "private", Username: "john"}}},
+		{name: "basic group", msg: Message{MessageID: 1, Chat: Chat{ID: -123456789, Type: "group", Title: "Old"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(what string, link string, ok bool, want string) {
+				if ok != (want != "") || link != want {
+					t.Errorf("%s() = %q, %v; want %q", what, link, ok, want)
+				}
+			}
+			link, ok := tt.msg.PermalinkPublic()
+			check("PermalinkPublic", link, ok, tt.wantPublic)
+			link, ok = tt.msg.PermalinkPrivate()
+			check("PermalinkPrivate", link, ok, tt.wantPrivate)
+			link, ok = tt.msg.Permalink()
+			check("Permalink", link, ok, tt.wantAny)
+		})
+	}
+}
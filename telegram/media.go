@@ -0,0 +1,120 @@
+/* media.go : helpers on the parameters of the media methods
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * About durations: when sending a new file, Telegram reads the duration from
+ * the file itself, so there is no need to compute it. The duration fields are
+ * omitempty on purpose: a 0 is not sent at all, instead of being taken as a
+ * real (and wrong) duration of 0 seconds.
+ */
+
+package telegram
+
+import (
+	"bytes"
+	"fmt"
+	"image/jpeg"
+	"time"
+)
+
+// Limits of the thumbnails sent with audio, video and document files.
+// The size must be less than MaxThumbnailSize, the dimensions at most MaxThumbnailDimension
+const (
+	MaxThumbnailSize      = 200 * 1024
+	MaxThumbnailDimension = 320
+)
+
+// ValidateThumbnail checks the content of a thumbnail before it is uploaded: Telegram wants a JPEG
+// of less than 200 kB, at most 320 pixels wide and tall. Thumbnails that break these rules are
+// not always rejected, they are just silently dropped, which is why it's worth checking
+func ValidateThumbnail(data []byte) error {
+	if len(data) >= MaxThumbnailSize {
+		return fmt.Errorf("telegram: thumbnail is %d bytes, it must be less than %d", len(data), MaxThumbnailSize)
+	}
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("telegram: thumbnail must be a JPEG: %w", err)
+	}
+	if cfg.Width > MaxThumbnailDimension || cfg.Height > MaxThumbnailDimension {
+		return fmt.Errorf("telegram: thumbnail is %dx%d, the limit is %dx%d", cfg.Width, cfg.Height, MaxThumbnailDimension, MaxThumbnailDimension)
+	}
+	return nil
+}
+
+// Converts a duration to the whole seconds Telegram wants. Anything shorter than
+// a second but positive becomes 1, so that it isn't dropped as "unset"
+func durationSeconds(d time.Duration) int64 {
+	if d <= 0 {
+		return 0
+	}
+	if s := int64(d.Round(time.Second) / time.Second); s > 0 {
+		return s
+	}
+	return 1
+}
+
+// WithDuration returns a copy of the parameters with the duration set from d.
+// A zero or negative d leaves the duration unset
+func (p SendVoiceParams) WithDuration(d time.Duration) SendVoiceParams {
+	p.Duration = durationSeconds(d)
+	return p
+}
+
+// WithDuration returns a copy of the parameters with the duration set from d.
+// A zero or negative d leaves the duration unset
+func (p SendVideoNoteParams) WithDuration(d time.Duration) SendVideoNoteParams {
+	p.Duration = durationSeconds(d)
+	return p
+}
+
+// WithDuration returns a copy of the parameters with the duration set from d.
+// A zero or negative d leaves the duration unset
+func (p SendVideoParams) WithDuration(d time.Duration) SendVideoParams {
+	p.Duration = durationSeconds(d)
+	return p
+}
+
+// WithDuration returns a copy of the parameters with the duration set from d.
+// A zero or negative d leaves the duration unset
+func (p SendAudioParams) WithDuration(d time.Duration) SendAudioParams {
+	p.Duration = durationSeconds(d)
+	return p
+}
+
+// One item of a media group that was sent: what was asked and what Telegram made of it
+type SentMedia struct {
+	Input   InputMedia
+	Message Message
+}
+
+// PairMediaGroup pairs the messages returned by sendMediaGroup with the InputMedia of the request.
+// Telegram returns the messages in the order of the items, all with the same MediaGroupID,
+// so they are paired by position; a different number of messages is an error
+func PairMediaGroup(params *SendMediaGroupParams, sent []Message) ([]SentMedia, error) {
+	if len(sent) != len(params.Media) {
+		return nil, fmt.Errorf("telegram: sent %d media but got %d messages", len(params.Media), len(sent))
+	}
+	pairs := make([]SentMedia, len(sent))
+	for i := range sent {
+		pairs[i] = SentMedia{Input: params.Media[i], Message: sent[i]}
+	}
+	return pairs, nil
+}
+
+// FileID returns the file_id of the media of the message, to send it again without uploading it.
+// For a photo it is the one of the largest size. It is empty if the message has no photo, video, audio or document
+func (s *SentMedia) FileID() string {
+	m := &s.Message
+	switch {
+	case len(m.Photo) > 0:
+		return m.Photo[len(m.Photo)-1].FileID
+	case m.Video != nil:
+		return m.Video.FileID
+	case m.Audio != nil:
+		return m.Audio.FileID
+	case m.Document != nil:
+		return m.Document.FileID
+	}
+	return ""
+}
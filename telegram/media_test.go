@@ -0,0 +1,118 @@
+/* media_test.go : tests for the checks on media
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encodeJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	if err := jpeg.Encode(&b, image.NewGray(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// Pads a JPEG to size bytes: only the header is decoded, so the padding doesn't matter
+func padTo(data []byte, size int) []byte {
+	return append(append([]byte(nil), data...), make([]byte, size-len(data))...)
+}
+
+func TestValidateThumbnail(t *testing.T) {
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, image.NewGray(image.Rect(0, 0, 10, 10))); err != nil {
+		t.Fatal(err)
+	}
+	small := encodeJPEG(t, 100, 100)
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "small JPEG", data: small},
+		{name: "largest dimensions", data: encodeJPEG(t, MaxThumbnailDimension, MaxThumbnailDimension)},
+		{name: "too wide", data: encodeJPEG(t, MaxThumbnailDimension+1, 100), wantErr: true},
+		{name: "too tall", data: encodeJPEG(t, 100, MaxThumbnailDimension+1), wantErr: true},
+		{name: "one byte under the limit", data: padTo(small, MaxThumbnailSize-1)},
+		{name: "exactly 200 kB", data: padTo(small, MaxThumbnailSize), wantErr: true},
+		{name: "PNG", data: pngData.Bytes(), wantErr: true},
+		{name: "empty", data: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateThumbnail(tt.data); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateThumbnail() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPairMediaGroup(t *testing.T) {
+	params := &SendMediaGroupParams{Media: []InputMedia{
+		{Photo: &InputMediaPhoto{Media: "attach://first"}},
+		{Video: &InputMediaVideo{Media: "attach://second"}},
+		{Photo: &InputMediaPhoto{Media: "attach://third"}},
+	}}
+	// The result of sendMediaGroup: one message per item, in the order of the items
+	const result = `[
+		{"message_id": 20, "date": 0, "chat": {"id": 7, "type": "private"}, "media_group_id": "1357",
+		 "photo": [{"file_id": "small", "file_unique_id": "s", "width": 90, "height": 90},
+		           {"file_id": "large", "file_unique_id": "l", "width": 1280, "height": 1280}]},
+		{"message_id": 21, "date": 0, "chat": {"id": 7, "type": "private"}, "media_group_id": "1357",
+		 "video": {"file_id": "video", "file_unique_id": "v", "width": 640, "height": 480, "duration": 3}},
+		{"message_id": 22, "date": 0, "chat": {"id": 7, "type": "private"}, "media_group_id": "1357",
+		 "photo": [{"file_id": "other", "file_unique_id": "o", "width": 800, "height": 600}]}
+	]`
+	var sent []Message
+	if err := json.Unmarshal([]byte(result), &sent); err != nil {
+		t.Fatal(err)
+	}
+
+	pairs, err := PairMediaGroup(params, sent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		media     string
+		messageID int64
+		fileID    string
+	}{
+		{"attach://first", 20, "large"},
+		{"attach://second", 21, "video"},
+		{"attach://third", 22, "other"},
+	}
+	for i, w := range want {
+		p := pairs[i]
+		var media string
+		switch {
+		case p.Input.Photo != nil:
+			media = p.Input.Photo.Media
+		case p.Input.Video != nil:
+			media = p.Input.Video.Media
+		}
+		if media != w.media || p.Message.MessageID != w.messageID {
+			t.Errorf("pair %d is %s with message %d, want %s with %d", i, media, p.Message.MessageID, w.media, w.messageID)
+		}
+		if got := p.FileID(); got != w.fileID {
+			t.Errorf("pair %d FileID() = %q, want %q", i, got, w.fileID)
+		}
+		if p.Message.MediaGroupID != "1357" {
+			t.Errorf("pair %d MediaGroupID = %q, want 1357", i, p.Message.MediaGroupID)
+		}
+	}
+
+	if _, err := PairMediaGroup(params, sent[:2]); err == nil {
+		t.Error("PairMediaGroup accepted fewer messages than media")
+	}
+}
@@ -0,0 +1,52 @@
+/* members.go : changes of chat members
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * "In the chat" is not a single status: a restricted user may or may not
+ * be a member (see ChatMemberRestricted.IsMember), and a user that left and
+ * a user that was banned are both out, but only the second can't come back.
+ */
+
+package telegram
+
+// IsPresent reports whether the user is in the chat: owner, administrator, member,
+// or restricted while still being a member
+func (m *ChatMember) IsPresent() bool {
+	switch {
+	case m.Owner != nil, m.Administrator != nil, m.Member != nil:
+		return true
+	case m.Restricted != nil:
+		return m.Restricted.IsMember
+	}
+	return false
+}
+
+// IsAdmin reports whether the user is the owner or an administrator of the chat
+func (m *ChatMember) IsAdmin() bool {
+	return m.Owner != nil || m.Administrator != nil
+}
+
+// WasAdded reports whether the user entered the chat with this change (joined, was added, or was
+// readmitted). With my_chat_member updates, this is the moment to send a welcome message
+func (u *ChatMemberUpdated) WasAdded() bool {
+	return !u.OldChatMember.IsPresent() && u.NewChatMember.IsPresent()
+}
+
+// WasRemoved reports whether the user is out of the chat after this change, whether they left
+// or were banned. Use WasKicked to tell the two apart
+func (u *ChatMemberUpdated) WasRemoved() bool {
+	return u.OldChatMember.IsPresent() && !u.NewChatMember.IsPresent()
+}
+
+// WasKicked reports whether the user was banned with this change. A ban also applies to users that
+// already left, so it isn't necessarily a removal. For a bot in a private chat this means it was blocked
+func (u *ChatMemberUpdated) WasKicked() bool {
+	return u.OldChatMember.Banned == nil && u.NewChatMember.Banned != nil
+}
+
+// WasPromoted reports whether the user became an administrator (or the owner) with this change.
+// Changes of the rights of someone who already was an administrator don't count
+func (u *ChatMemberUpdated) WasPromoted() bool {
+	return !u.OldChatMember.IsAdmin() && u.NewChatMember.IsAdmin()
+}
@@ -0,0 +1,67 @@
+/* members_test.go : tests for the changes of chat members
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestChatMemberTransitions(t *testing.T) {
+	const user = `"user":{"id":42,"is_bot":true,"first_name":"Bot"}`
+	statuses := map[string]string{
+		"owner":               `{"status":"creator",` + user + `,"is_anonymous":false}`,
+		"administrator":       `{"status":"administrator",` + user + `,"can_be_edited":false,"can_delete_messages":true}`,
+		"member":              `{"status":"member",` + user + `}`,
+		"restricted member":   `{"status":"restricted",` + user + `,"is_member":true,"until_date":0}`,
+		"restricted, outside": `{"status":"restricted",` + user + `,"is_member":false,"until_date":0}`,
+		"left":                `{"status":"left",` + user + `}`,
+		"kicked":              `{"status":"kicked",` + user + `,"until_date":0}`,
+	}
+	tests := []struct {
+		old, new                         string
+		added, removed, kicked, promoted bool
+	}{
+		{old: "left", new: "member", added: true},
+		{old: "kicked", new: "member", added: true},
+		{old: "left", new: "administrator", added: true, promoted: true},
+		{old: "restricted, outside", new: "restricted member", added: true},
+		{old: "member", new: "left", removed: true},
+		{old: "member", new: "kicked", removed: true, kicked: true},
+		{old: "administrator", new: "kicked", removed: true, kicked: true},
+		{old: "left", new: "kicked", kicked: true},
+		{old: "restricted member", new: "restricted, outside", removed: true},
+		{old: "member", new: "administrator", promoted: true},
+		{old: "administrator", new: "administrator"},
+		{old: "administrator", new: "member"},
+		{old: "owner", new: "administrator"},
+		{old: "member", new: "restricted member"},
+		{old: "kicked", new: "left"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.old+" to "+tt.new, func(t *testing.T) {
+			data := `{"chat":{"id":-1001234567890,"type":"supergroup"},"from":{"id":1,"is_bot":false,"first_name":"Admin"},"date":1700000000,` +
+				`"old_chat_member":` + statuses[tt.old] + `,"new_chat_member":` + statuses[tt.new] + `}`
+			var u ChatMemberUpdated
+			if err := json.Unmarshal([]byte(data), &u); err != nil {
+				t.Fatal(err)
+			}
+			if got := u.WasAdded(); got != tt.added {
+				t.Errorf("WasAdded() = %v, want %v", got, tt.added)
+			}
+			if got := u.WasRemoved(); got != tt.removed {
+				t.Errorf("WasRemoved() = %v, want %v", got, tt.removed)
+			}
+			if got := u.WasKicked(); got != tt.kicked {
+				t.Errorf("WasKicked() = %v, want %v", got, tt.kicked)
+			}
+			if got := u.WasPromoted(); got != tt.promoted {
+				t.Errorf("WasPromoted() = %v, want %v", got, tt.promoted)
+			}
+		})
+	}
+}
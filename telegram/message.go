@@ -0,0 +1,190 @@
+/* message.go : helpers on received messages
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// EditDate returns the time the message was last edited.
+// The boolean is false if the message was never edited (edit_date is absent)
+func (m *Message) EditDate() (time.Time, bool) {
+	if m.EditDateUnix == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(m.EditDateUnix, 0), true
+}
+
+// CaptionText returns the part of the caption covered by the entity, which should be
+// one of CaptionEntities. Offsets are in UTF-16 code units, see EntityText
+func (m *Message) CaptionText(e MessageEntity) string {
+	return EntityText(m.Caption, e)
+}
+
+// IsServiceMessage reports whether the message is a service message (a pinned message,
+// a payment, a video chat event, ...) rather than content sent by a user.
+// Only the service messages modeled by Message are recognized
+func (m *Message) IsServiceMessage() bool {
+	return m.PinnedMessage != nil ||
+		m.MigrateToChatID != 0 ||
+		m.MigrateFromChatID != 0 ||
+		m.SuccessfulPayment != nil ||
+		m.WriteAccessAllowed != nil ||
+		m.ProximityAlertTriggered != nil ||
+		m.VideoChatScheduled != nil ||
+		m.VideoChatStarted != nil ||
+		m.VideoChatEnded != nil ||
+		m.VideoChatParticipantsInvited != nil ||
+		m.ChecklistTasksDone != nil ||
+		m.ChecklistTasksAdded != nil ||
+		m.GiveawayCreated != nil ||
+		m.GiveawayCompleted != nil
+}
+
+// IsAnonymousAdmin reports whether the message was sent by an anonymous administrator of the group:
+// its sender is the group itself, and From is GroupAnonymousBotID
+func (m *Message) IsAnonymousAdmin() bool {
+	return m.SenderChat != nil && m.SenderChat.ID == m.Chat.ID && m.Chat.Type != "channel"
+}
+
+// IsSentAsChannel reports whether the message was sent in a group on behalf of a channel, either
+// by a user writing as one of their channels or by the automatic forward of a post to the discussion group
+func (m *Message) IsSentAsChannel() bool {
+	return m.SenderChat != nil && m.SenderChat.ID != m.Chat.ID
+}
+
+// IsBusiness reports whether the message belongs to a chat of a connected business account.
+// Whatever is sent to that chat must carry the same BusinessConnectionID, which Reply copies
+func (m *Message) IsBusiness() bool {
+	return m.BusinessConnectionID != ""
+}
+
+// Reply returns the parameters to send text as a reply to the message, in the same chat
+// and in the same forum topic (and through the same business connection)
+func (m *Message) Reply(text string) SendMessageParams {
+	return SendMessageParams{
+		BaseSendParams: m.replyBase(),
+		Text:           text,
+	}
+}
+
+// The BaseSendParams of a reply to m. The thread is kept only for topic messages:
+// in a supergroup that is not a forum, message_thread_id is the thread of replies, not a topic
+func (m *Message) replyBase() BaseSendParams {
+	base := BaseSendParams{
+		ChatID:               NewChatID(m.Chat.ID),
+		ReplyParameters:      &ReplyParameters{MessageID: m.MessageID},
+		BusinessConnectionID: m.BusinessConnectionID,
+	}
+	if m.IsTopicMessage {
+		base.MessageThreadID = m.MessageThreadID
+	}
+	return base
+}
+
+// TopicID returns the forum topic the message was sent to. The boolean is false for
+// messages outside of a topic: in a supergroup that is not a forum, MessageThreadID is
+// the thread of replies and not a topic, so it is not returned
+func (m *Message) TopicID() (int64, bool) {
+	if !m.IsTopicMessage {
+		return 0, false
+	}
+	return m.MessageThreadID, true
+}
+
+// ExtractEntities returns the values of the entities of the given type ("url", "mention",
+// "hashtag", ...) in the text or in the caption of the message, in order of appearance.
+// For "text_link" entities it returns the URL they point to instead of the text shown
+func (m *Message) ExtractEntities(entityType string) []string {
+	text, entities := m.Text, m.Entities
+	if text == "" {
+		text, entities = m.Caption, m.CaptionEntities
+	}
+
+	var values []string
+	var units []uint16
+	for _, e := range entities {
+		if e.Type != entityType {
+			continue
+		}
+		if e.Type == "text_link" {
+			values = append(values, e.URL)
+			continue
+		}
+		if units == nil {
+			units = utf16.Encode([]rune(text))
+		}
+		values = append(values, entityText(units, e))
+	}
+	return values
+}
+
+// Command returns the name of the command the message starts with (without the "/" and the
+// "@botname" suffix) and the text after it. The boolean is false if the message is not a command.
+// Only a bot_command entity at the very beginning of the text counts, like the clients do
+func (m *Message) Command() (name, args string, ok bool) {
+	if len(m.Entities) == 0 || m.Entities[0].Type != "bot_command" || m.Entities[0].Offset != 0 {
+		return "", "", false
+	}
+	command := EntityText(m.Text, m.Entities[0])
+	name, _, _ = strings.Cut(strings.TrimPrefix(command, "/"), "@")
+	args = strings.TrimSpace(m.Text[len(command):])
+	return name, args, true
+}
+
+// The entities a quote keeps from the original message, the others must not be sent with it
+var quoteEntityTypes = map[string]bool{
+	"bold": true, "italic": true, "underline": true, "strikethrough": true, "spoiler": true, "custom_emoji": true,
+}
+
+// Maximum length of the quote of a reply, in UTF-16 code units
+const MaxQuoteLength = 1024
+
+// QuoteReply returns the ReplyParameters to reply to msg quoting quote, which must appear in its text
+// (or caption) and be at most MaxQuoteLength long. Telegram refuses the reply unless the quote matches exactly, formatting included,
+// so the position is computed in UTF-16 units and the formatting entities that overlap the quote are
+// copied, cut to it. The first occurrence of quote is used
+func QuoteReply(msg *Message, quote string) (ReplyParameters, error) {
+	text, entities := msg.Text, msg.Entities
+	if text == "" {
+		text, entities = msg.Caption, msg.CaptionEntities
+	}
+	i := strings.Index(text, quote)
+	if quote == "" || i < 0 {
+		return ReplyParameters{}, fmt.Errorf("telegram: quote %q not found in message %d", quote, msg.MessageID)
+	}
+
+	length := UTF16Length(quote)
+	if length > MaxQuoteLength {
+		return ReplyParameters{}, fmt.Errorf("telegram: quote is %d UTF-16 units long, the limit is %d", length, MaxQuoteLength)
+	}
+
+	start := UTF16Length(text[:i])
+	end := start + length
+	var quoteEntities []MessageEntity
+	for _, e := range entities {
+		if !quoteEntityTypes[e.Type] {
+			continue
+		}
+		from, to := max(e.Offset, start), min(e.Offset+e.Length, end)
+		if from >= to {
+			continue
+		}
+		e.Offset, e.Length = from-start, to-from
+		quoteEntities = append(quoteEntities, e)
+	}
+
+	return ReplyParameters{
+		MessageID:     msg.MessageID,
+		Quote:         quote,
+		QuoteEntities: quoteEntities,
+		QuotePosition: start,
+	}, nil
+}
@@ -0,0 +1,414 @@
+/* message_test.go : tests for the helpers on messages
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDecodeServiceMessages(t *testing.T) {
+	const base = `"message_id":7,"date":1700000000,"chat":{"id":-1001234567890,"type":"supergroup","title":"Group"}`
+	tests := []struct {
+		name  string
+		json  string
+		check func(t *testing.T, m *Message)
+	}{
+		{
+			name: "write access allowed",
+			json: `{` + base + `,"write_access_allowed":{"from_request":true,"web_app_name":"shop"}}`,
+			check: func(t *testing.T, m *Message) {
+				w := m.WriteAccessAllowed
+				if w == nil || !w.FromRequest || w.WebAppName != "shop" || w.FromAttachmentMenu {
+					t.Errorf("WriteAccessAllowed = %+v", w)
+				}
+			},
+		},
+		{
+			name: "write access allowed, empty object",
+			json: `{` + base + `,"write_access_allowed":{}}`,
+			check: func(t *testing.T, m *Message) {
+				if m.WriteAccessAllowed == nil {
+					t.Error("WriteAccessAllowed is nil")
+				}
+			},
+		},
+		{
+			name: "proximity alert triggered",
+			json: `{` + base + `,"proximity_alert_triggered":{"traveler":{"id":1,"is_bot":false,"first_name":"Ann"},"watcher":{"id":2,"is_bot":false,"first_name":"Bob"},"distance":42}}`,
+			check: func(t *testing.T, m *Message) {
+				p := m.ProximityAlertTriggered
+				if p == nil || p.Traveler.ID != 1 || p.Watcher.ID != 2 || p.Distance != 42 {
+					t.Errorf("ProximityAlertTriggered = %+v", p)
+				}
+			},
+		},
+		{
+			name: "video chat scheduled",
+			json: `{` + base + `,"video_chat_scheduled":{"start_date":1700003600}}`,
+			check: func(t *testing.T, m *Message) {
+				if v := m.VideoChatScheduled; v == nil || v.StartDate != 1700003600 {
+					t.Errorf("VideoChatScheduled = %+v", v)
+				}
+			},
+		},
+		{
+			name: "video chat started",
+			json: `{` + base + `,"video_chat_started":{}}`,
+			check: func(t *testing.T, m *Message) {
+				if m.VideoChatStarted == nil {
+					t.Error("VideoChatStarted is nil")
+				}
+			},
+		},
+		{
+			name: "video chat ended",
+			json: `{` + base + `,"video_chat_ended":{"duration":3600}}`,
+			check: func(t *testing.T, m *Message) {
+				if v := m.VideoChatEnded; v == nil || v.Duration != 3600 {
+					t.Errorf("VideoChatEnded = %+v", v)
+				}
+			},
+		},
+		{
+			name: "video chat participants invited",
+			json: `{` + base + `,"video_chat_participants_invited":{"users":[{"id":1,"is_bot":false,"first_name":"Ann"},{"id":2,"is_bot":false,"first_name":"Bob"}]}}`,
+			check: func(t *testing.T, m *Message) {
+				v := m.VideoChatParticipantsInvited
+				if v == nil || len(v.Users) != 2 || v.Users[1].FirstName != "Bob" {
+					t.Errorf("VideoChatParticipantsInvited = %+v", v)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Message
+			if err := json.Unmarshal([]byte(tt.json), &m); err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, &m)
+			if !m.IsServiceMessage() {
+				t.Error("IsServiceMessage() = false")
+			}
+		})
+	}
+
+	t.Run("text message", func(t *testing.T) {
+		var m Message
+		if err := json.Unmarshal([]byte(`{`+base+`,"text":"hello"}`), &m); err != nil {
+			t.Fatal(err)
+		}
+		if m.IsServiceMessage() {
+			t.Error("IsServiceMessage() = true for a text message")
+		}
+	})
+}
+
+func TestExtractEntities(t *testing.T) {
+	// 😀 takes two UTF-16 units, so every offset after it is one more than the rune index
+	text := "😀 see https://a.io #go @ann and docs, also #bots"
+	entities := []MessageEntity{
+		{Type: "url", Offset: 7, Length: 12},
+		{Type: "hashtag", Offset: 20, Length: 3},
+		{Type: "mention", Offset: 24, Length: 4},
+		{Type: "text_link", Offset: 33, Length: 4, URL: "https://docs.example.com"},
+		{Type: "hashtag", Offset: 44, Length: 5},
+	}
+	tests := []struct {
+		entityType string
+		want       []string
+	}{
+		{"url", []string{"https://a.io"}},
+		{"hashtag", []string{"#go", "#bots"}},
+		{"mention", []string{"@ann"}},
+		{"text_link", []string{"https://docs.example.com"}},
+		{"email", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.entityType, func(t *testing.T) {
+			inText := Message{Text: text, Entities: entities}
+			if got := inText.ExtractEntities(tt.entityType); !slices.Equal(got, tt.want) {
+				t.Errorf("ExtractEntities(%q) of the text = %q, want %q", tt.entityType, got, tt.want)
+			}
+			caption := Message{Caption: text, CaptionEntities: entities}
+			if got := caption.ExtractEntities(tt.entityType); !slices.Equal(got, tt.want) {
+				t.Errorf("ExtractEntities(%q) of the caption = %q, want %q", tt.entityType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeGiveaways(t *testing.T) {
+	const base = `"message_id":7,"date":1700000000,"chat":{"id":-1009876543210,"type":"channel","title":"News"}`
+	const chats = `"chats":[{"id":-1009876543210,"type":"channel","title":"News"},{"id":-1001111111111,"type":"channel","title":"Other"}]`
+	tests := []struct {
+		name        string
+		json        string
+		wantService bool
+		check       func(t *testing.T, m *Message)
+	}{
+		{
+			name: "premium giveaway",
+			json: `{` + base + `,"giveaway":{` + chats + `,"winners_selection_date":1700600000,"winner_count":3,"only_new_members":true,"has_public_winners":true,"prize_description":"and a mug","country_codes":["IT","FR"],"premium_subscription_month_count":6}}`,
+			check: func(t *testing.T, m *Message) {
+				g := m.Giveaway
+				if g == nil || len(g.Chats) != 2 || g.WinnersSelectionDate != 1700600000 || g.WinnerCount != 3 || !g.OnlyNewMembers ||
+					!g.HasPublicWinners || g.PrizeDescription != "and a mug" || len(g.CountryCodes) != 2 ||
+					g.PremiumSubscriptionMonthCount != 6 || g.PrizeStarCount != 0 {
+					t.Errorf("Giveaway = %+v", g)
+				}
+			},
+		},
+		{
+			name: "Stars giveaway",
+			json: `{` + base + `,"giveaway":{` + chats + `,"winners_selection_date":1700600000,"winner_count":10,"prize_star_count":5000}}`,
+			check: func(t *testing.T, m *Message) {
+				g := m.Giveaway
+				if g == nil || g.WinnerCount != 10 || g.PrizeStarCount != 5000 || g.PremiumSubscriptionMonthCount != 0 {
+					t.Errorf("Giveaway = %+v", g)
+				}
+			},
+		},
+		{
+			name: "premium winners",
+			json: `{` + base + `,"giveaway_winners":{"chat":{"id":-1009876543210,"type":"channel"},"giveaway_message_id":3,"winners_selection_date":1700600000,"winner_count":2,"winners":[{"id":1,"is_bot":false,"first_name":"A"},{"id":2,"is_bot":false,"first_name":"B"}],"additional_chat_count":1,"premium_subscription_month_count":6,"unclaimed_prize_count":1,"only_new_members":true,"prize_description":"and a mug"}}`,
+			check: func(t *testing.T, m *Message) {
+				w := m.GiveawayWinners
+				if w == nil || w.GiveawayMessageID != 3 || w.WinnerCount != 2 || len(w.Winners) != 2 || w.Winners[1].ID != 2 ||
+					w.AdditionalChatCount != 1 || w.PremiumSubscriptionMonthCount != 6 || w.UnclaimedPrizeCount != 1 ||
+					!w.OnlyNewMembers || w.WasRefunded || w.PrizeDescription != "and a mug" {
+					t.Errorf("GiveawayWinners = %+v", w)
+				}
+			},
+		},
+		{
+			name: "Stars winners, refunded",
+			json: `{` + base + `,"giveaway_winners":{"chat":{"id":-1009876543210,"type":"channel"},"giveaway_message_id":3,"winners_selection_date":1700600000,"winner_count":1,"winners":[],"prize_star_count":5000,"was_refunded":true}}`,
+			check: func(t *testing.T, m *Message) {
+				w := m.GiveawayWinners
+				if w == nil || w.PrizeStarCount != 5000 || !w.WasRefunded {
+					t.Errorf("GiveawayWinners = %+v", w)
+				}
+			},
+		},
+		{
+			name:        "premium giveaway created",
+			json:        `{` + base + `,"giveaway_created":{}}`,
+			wantService: true,
+			check: func(t *testing.T, m *Message) {
+				if c := m.GiveawayCreated; c == nil || c.PrizeStarCount != 0 {
+					t.Errorf("GiveawayCreated = %+v", c)
+				}
+			},
+		},
+		{
+			name:        "Stars giveaway created",
+			json:        `{` + base + `,"giveaway_created":{"prize_star_count":5000}}`,
+			wantService: true,
+			check: func(t *testing.T, m *Message) {
+				if c := m.GiveawayCreated; c == nil || c.PrizeStarCount != 5000 {
+					t.Errorf("GiveawayCreated = %+v", c)
+				}
+			},
+		},
+		{
+			name:        "Stars giveaway completed",
+			json:        `{` + base + `,"giveaway_completed":{"winner_count":10,"unclaimed_prize_count":2,"is_star_giveaway":true,"giveaway_message":{` + base + `,"giveaway":{` + chats + `,"winners_selection_date":1700600000,"winner_count":10,"prize_star_count":5000}}}}`,
+			wantService: true,
+			check: func(t *testing.T, m *Message) {
+				c := m.GiveawayCompleted
+				if c == nil || c.WinnerCount != 10 || c.UnclaimedPrizeCount != 2 || !c.IsStarGiveaway ||
+					c.GiveawayMessage == nil || c.GiveawayMessage.Giveaway == nil || c.GiveawayMessage.Giveaway.PrizeStarCount != 5000 {
+					t.Errorf("GiveawayCompleted = %+v", c)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Message
+			if err := json.Unmarshal([]byte(tt.json), &m); err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, &m)
+			if got := m.IsServiceMessage(); got != tt.wantService {
+				t.Errorf("IsServiceMessage() = %v, want %v", got, tt.wantService)
+			}
+		})
+	}
+}
+
+func TestDecodeContact(t *testing.T) {
+	const data = `{"message_id":8,"date":1700000000,"chat":{"id":7,"type":"private"},"contact":{"phone_number":"+390612345678","first_name":"Ann","last_name":"Rossi","user_id":7,"vcard":"BEGIN:VCARD\nEND:VCARD"}}`
+	var m Message
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	want := Contact{PhoneNumber: "+390612345678", FirstName: "Ann", LastName: "Rossi", UserID: 7, VCard: "BEGIN:VCARD\nEND:VCARD"}
+	if m.Contact == nil || *m.Contact != want {
+		t.Errorf("Contact = %+v, want %+v", m.Contact, want)
+	}
+}
+
+func TestDecodeViaBot(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want *User
+	}{
+		{
+			name: "sent through an inline bot",
+			data: `{"message_id":12,"date":1700000000,"chat":{"id":-100,"type":"supergroup"},"text":"Result 1","via_bot":{"id":99,"is_bot":true,"first_name":"Finder","username":"finderbot"}}`,
+			want: &User{ID: 99, IsBot: true, FirstName: "Finder", Username: "finderbot"},
+		},
+		{
+			name: "sent directly",
+			data: `{"message_id":13,"date":1700000000,"chat":{"id":-100,"type":"supergroup"},"text":"hi"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Message
+			if err := json.Unmarshal([]byte(tt.data), &m); err != nil {
+				t.Fatal(err)
+			}
+			if (m.ViaBot == nil) != (tt.want == nil) || (m.ViaBot != nil && !reflect.DeepEqual(*m.ViaBot, *tt.want)) {
+				t.Errorf("ViaBot = %+v, want %+v", m.ViaBot, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuoteReply(t *testing.T) {
+	msg := &Message{
+		MessageID: 5,
+		Text:      "😀 Hello *bold* 🇮🇹 world",
+		Entities: []MessageEntity{
+			{Type: "bold", Offset: 9, Length: 6},    // "*bold*"
+			{Type: "url", Offset: 0, Length: 2},     // not kept in quotes
+			{Type: "italic", Offset: 16, Length: 4}, // the flag
+		},
+	}
+	tests := []struct {
+		name         string
+		msg          *Message
+		quote        string
+		wantPosition int64
+		wantEntities []MessageEntity
+		wantErr      bool
+	}{
+		{name: "after an emoji", msg: msg, quote: "Hello", wantPosition: 3},
+		{
+			name:         "entity cut to the quote",
+			msg:          msg,
+			quote:        "Hello *bo",
+			wantPosition: 3,
+			wantEntities: []MessageEntity{{Type: "bold", Offset: 6, Length: 3}},
+		},
+		{
+			name:         "flag inside the quote",
+			msg:          msg,
+			quote:        "🇮🇹 world",
+			wantPosition: 16,
+			wantEntities: []MessageEntity{{Type: "italic", Offset: 0, Length: 4}},
+		},
+		{name: "not found", msg: msg, quote: "bye", wantErr: true},
+		{name: "empty", msg: msg, quote: "", wantErr: true},
+		{name: "caption", msg: &Message{MessageID: 6, Caption: "photo of 🌅"}, quote: "🌅", wantPosition: 9},
+		{
+			name:         "emoji quote at the limit",
+			msg:          &Message{MessageID: 7, Text: "a" + strings.Repeat("😀", MaxQuoteLength/2)},
+			quote:        strings.Repeat("😀", MaxQuoteLength/2),
+			wantPosition: 1,
+		},
+		{
+			name:    "emoji quote over the limit",
+			msg:     &Message{MessageID: 7, Text: strings.Repeat("😀", MaxQuoteLength/2+1)},
+			quote:   strings.Repeat("😀", MaxQuoteLength/2+1),
+			wantErr: true,
+		},
+		{
+			name:    "fewer runes than the limit, more units",
+			msg:     &Message{MessageID: 8, Text: strings.Repeat("😀", 600)},
+			quote:   strings.Repeat("😀", 600),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := QuoteReply(tt.msg, tt.quote)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("QuoteReply() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.MessageID != tt.msg.MessageID || got.Quote != tt.quote || got.QuotePosition != tt.wantPosition {
+				t.Errorf("QuoteReply() = %+v, want position %d", got, tt.wantPosition)
+			}
+			if len(got.QuoteEntities) != len(tt.wantEntities) {
+				t.Fatalf("QuoteEntities = %+v, want %+v", got.QuoteEntities, tt.wantEntities)
+			}
+			for i, e := range got.QuoteEntities {
+				w := tt.wantEntities[i]
+				if e.Type != w.Type || e.Offset != w.Offset || e.Length != w.Length {
+					t.Errorf("entity %d = %+v, want %+v", i, e, w)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeExternalReply(t *testing.T) {
+	const data = `{
+		"message_id": 9, "date": 1700000000, "chat": {"id": 7, "type": "private"}, "text": "look at this",
+		"external_reply": {
+			"origin": {"type": "channel", "date": 1699990000, "chat": {"id": -1009876543210, "type": "channel", "title": "News"}, "message_id": 120},
+			"chat": {"id": -1009876543210, "type": "channel", "title": "News"},
+			"message_id": 120,
+			"photo": [{"file_id": "small", "file_unique_id": "s", "width": 90, "height": 60}, {"file_id": "big", "file_unique_id": "b", "width": 1280, "height": 853}]
+		},
+		"quote": {"text": "breaking", "position": 0, "is_manual": true}
+	}`
+	var m Message
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	r := m.ExternalReply
+	if r == nil {
+		t.Fatal("ExternalReply is nil")
+	}
+	if c := r.Origin.Channel; c == nil || c.Chat.ID != -1009876543210 || c.MessageID != 120 || c.Date != 1699990000 {
+		t.Errorf("Origin = %+v", r.Origin)
+	}
+	if r.Chat == nil || r.Chat.Title != "News" || r.MessageID != 120 {
+		t.Errorf("Chat = %+v, MessageID = %d", r.Chat, r.MessageID)
+	}
+	if len(r.Photo) != 2 || r.Photo[1].FileID != "big" {
+		t.Errorf("Photo = %+v", r.Photo)
+	}
+	if m.Quote == nil || m.Quote.Text != "breaking" || !m.Quote.IsManual {
+		t.Errorf("Quote = %+v", m.Quote)
+	}
+	if m.ReplyToStory != nil {
+		t.Error("an external reply was decoded as a reply to a story")
+	}
+
+	const story = `{"message_id": 10, "date": 1700000000, "chat": {"id": 7, "type": "private"},
+		"reply_to_story": {"chat": {"id": -1009876543210, "type": "channel"}, "id": 33}}`
+	m = Message{}
+	if err := json.Unmarshal([]byte(story), &m); err != nil {
+		t.Fatal(err)
+	}
+	if s := m.ReplyToStory; s == nil || s.ID != 33 || s.Chat.ID != -1009876543210 {
+		t.Errorf("ReplyToStory = %+v", s)
+	}
+}
@@ -0,0 +1,96 @@
+/* offset.go : remembering where long polling arrived
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * getUpdates confirms the updates older than the offset it is called with.
+ * If a bot crashes after handling some updates but before asking for the next
+ * ones, it gets them again on restart, unless it saved the offset somewhere.
+ */
+
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// OffsetStore persists the offset for getUpdates between restarts.
+// Load returns 0 if nothing was saved yet. Implementations must be safe for concurrent use
+type OffsetStore interface {
+	Load() (int64, error)
+	Save(offset int64) error
+}
+
+// NextOffset returns the offset that confirms all the given updates:
+// the highest update_id plus one. It returns 0 for an empty batch
+func NextOffset(updates []Update) int64 {
+	var next int64
+	for i := range updates {
+		if updates[i].UpdateID >= next {
+			next = updates[i].UpdateID + 1
+		}
+	}
+	return next
+}
+
+// FileOffsetStore is an OffsetStore that keeps the offset in a text file.
+// The file is replaced atomically, so a crash while saving leaves the previous offset
+type FileOffsetStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileOffsetStore returns a FileOffsetStore that uses the file at path
+func NewFileOffsetStore(path string) *FileOffsetStore {
+	return &FileOffsetStore{path: path}
+}
+
+// Load reads the saved offset, or returns 0 if the file doesn't exist
+func (s *FileOffsetStore) Load() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("telegram: loading offset: %w", err)
+	}
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("telegram: loading offset from %s: %w", s.path, err)
+	}
+	return offset, nil
+}
+
+// Save writes the offset to a temporary file and renames it over the old one
+func (s *FileOffsetStore) Save(offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("telegram: saving offset: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // fails harmlessly after the rename
+
+	if _, err := tmp.WriteString(strconv.FormatInt(offset, 10) + "\n"); err != nil {
+		tmp.Close()
+		return fmt.Errorf("telegram: saving offset: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("telegram: saving offset: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("telegram: saving offset: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/* offset_test.go : tests for the persistence of the polling offset
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestNextOffset(t *testing.T) {
+	tests := []struct {
+		name    string
+		updates []Update
+		want    int64
+	}{
+		{name: "empty batch", updates: nil, want: 0},
+		{name: "one update", updates: []Update{{UpdateID: 10}}, want: 11},
+		{name: "out of order", updates: []Update{{UpdateID: 12}, {UpdateID: 10}, {UpdateID: 11}}, want: 13},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextOffset(tt.updates); got != tt.want {
+				t.Errorf("NextOffset() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileOffsetStoreResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offset")
+
+	// First run: nothing saved yet, then a batch is handled and its offset saved
+	store := NewFileOffsetStore(path)
+	offset, err := store.Load()
+	if err != nil || offset != 0 {
+		t.Fatalf("Load() on a new store = %d, %v; want 0, nil", offset, err)
+	}
+	batch := []Update{{UpdateID: 500}, {UpdateID: 501}, {UpdateID: 502}}
+	if err := store.Save(NextOffset(batch)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Restart: a new store on the same file resumes after the last handled update
+	restarted := NewFileOffsetStore(path)
+	offset, err = restarted.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset != 503 {
+		t.Errorf("Load() after restart = %d, want 503", offset)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("temporary files left behind: %v", entries)
+	}
+}
+
+func TestFileOffsetStoreCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offset")
+	if err := os.WriteFile(path, []byte("not a number"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileOffsetStore(path).Load(); err == nil {
+		t.Error("Load() of a corrupt file succeeded")
+	}
+}
+
+func TestFileOffsetStoreConcurrent(t *testing.T) {
+	store := NewFileOffsetStore(filepath.Join(t.TempDir(), "offset"))
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := store.Save(int64(i)); err != nil {
+				t.Error(err)
+			}
+			if _, err := store.Load(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	offset, err := store.Load()
+	if err != nil || offset < 0 || offset >= 20 {
+		t.Errorf("Load() = %d, %v", offset, err)
+	}
+}
@@ -0,0 +1,421 @@
+/* params.go : client-side checks on method parameters
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Telegram answers a bad request with a 400 and a description that is not always
+ * helpful. Where the rules are documented, we check them before sending.
+ */
+
+package telegram
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Maximum length of a media caption, in UTF-16 code units
+const MaxCaptionLength = 1024
+
+// Maximum and minimum number of items in a media group
+const (
+	MinMediaGroupItems = 2
+	MaxMediaGroupItems = 10
+)
+
+// Validate checks the parameters of sendMessage
+func (p *SendMessageParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := p.ParseMode.Validate(); err != nil {
+		return err
+	}
+	if p.ParseMode != ParseModeNone && len(p.Entities) > 0 {
+		return fmt.Errorf("telegram: parse_mode and entities can't be used together")
+	}
+	if strings.TrimSpace(p.Text) == "" {
+		return fmt.Errorf("telegram: message text is empty")
+	}
+	if err := checkLength("message text", p.Text, p.ParseMode, MaxMessageTextLength); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.ReplyParameters != nil {
+		if err := p.ReplyParameters.QuoteParseMode.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendPhoto
+func (p *SendPhotoParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := validateCaption(p.Caption, p.ParseMode, p.CaptionEntities); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendVideo
+func (p *SendVideoParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := validateCaption(p.Caption, p.ParseMode, p.CaptionEntities); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendDocument
+func (p *SendDocumentParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := validateCaption(p.Caption, p.ParseMode, p.CaptionEntities); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendVoice
+func (p *SendVoiceParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := validateCaption(p.Caption, p.ParseMode, p.CaptionEntities); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendAudio
+func (p *SendAudioParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := validateCaption(p.Caption, p.ParseMode, p.CaptionEntities); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.Thumbnail != "" && !strings.HasPrefix(p.Thumbnail, "attach://") {
+		return fmt.Errorf("telegram: thumbnails can only be uploaded as new files (attach://), got %q", p.Thumbnail)
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendAnimation
+func (p *SendAnimationParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := validateCaption(p.Caption, p.ParseMode, p.CaptionEntities); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.Thumbnail != "" && !strings.HasPrefix(p.Thumbnail, "attach://") {
+		return fmt.Errorf("telegram: thumbnails can only be uploaded as new files (attach://), got %q", p.Thumbnail)
+	}
+	return nil
+}
+
+// Validate checks the parameters of copyMessage
+func (p *CopyMessageParams) Validate() error {
+	if p.ChatID.IsZero() {
+		return fmt.Errorf("telegram: chat_id is required")
+	}
+	if err := validateMessageThread(p.ChatID, p.MessageThreadID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.FromChatID.IsZero() || p.MessageID == 0 {
+		return fmt.Errorf("telegram: from_chat_id and message_id are required")
+	}
+	if err := p.ReplyMarkup.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := validateCaption(p.Caption, p.ParseMode, p.CaptionEntities); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendVideoNote
+func (p *SendVideoNoteParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendChatAction
+func (p *SendChatActionParams) Validate() error {
+	if err := validateMessageThread(p.ChatID, p.MessageThreadID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	switch p.Action {
+	case "typing", "upload_photo", "record_video", "upload_video", "record_voice", "upload_voice",
+		"upload_document", "choose_sticker", "find_location", "record_video_note", "upload_video_note":
+		return nil
+	}
+	return fmt.Errorf("telegram: unknown chat action %q", p.Action)
+}
+
+// Maximum size of the vCard of a contact, in bytes
+const MaxVCardLength = 2048
+
+// Validate checks the parameters of sendContact
+func (p *SendContactParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if strings.TrimSpace(p.PhoneNumber) == "" {
+		return fmt.Errorf("telegram: contact phone number is empty")
+	}
+	if strings.TrimSpace(p.FirstName) == "" {
+		return fmt.Errorf("telegram: contact first name is empty")
+	}
+	if len(p.VCard) > MaxVCardLength {
+		return fmt.Errorf("telegram: vCard is %d bytes, the limit is %d", len(p.VCard), MaxVCardLength)
+	}
+	return nil
+}
+
+// Validate checks the parameters of sendMediaGroup, including the caption of each item
+func (p *SendMediaGroupParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if !p.ReplyMarkup.IsZero() {
+		return fmt.Errorf("telegram: sendMediaGroup doesn't take a reply_markup")
+	}
+	if n := len(p.Media); n < MinMediaGroupItems || n > MaxMediaGroupItems {
+		return fmt.Errorf("telegram: a media group must have %d-%d items, got %d", MinMediaGroupItems, MaxMediaGroupItems, n)
+	}
+
+	var kind string
+	for i, item := range p.Media {
+		itemKind, err := item.validate()
+		if err != nil {
+			return fmt.Errorf("telegram: media group item %d: %w", i, err)
+		}
+		if itemKind == "animation" {
+			return fmt.Errorf("telegram: media group item %d: animations can't be part of a media group", i)
+		}
+		// Photos and videos can be mixed, audio files and documents can't be mixed with anything else
+		if itemKind == "video" {
+			itemKind = "photo"
+		}
+		if kind == "" {
+			kind = itemKind
+		} else if kind != itemKind {
+			return fmt.Errorf("telegram: media group item %d: audio files and documents can only be grouped with items of the same type", i)
+		}
+	}
+	return nil
+}
+
+// Checks a single item of a media group and returns its type
+func (m *InputMedia) validate() (string, error) {
+	var kind, caption string
+	var parseMode ParseMode
+	var entities []MessageEntity
+	set := 0
+	if m.Photo != nil {
+		set++
+		kind, caption, parseMode, entities = "photo", m.Photo.Caption, m.Photo.ParseMode, m.Photo.CaptionEntities
+	}
+	if m.Video != nil {
+		set++
+		kind, caption, parseMode, entities = "video", m.Video.Caption, m.Video.ParseMode, m.Video.CaptionEntities
+	}
+	if m.Audio != nil {
+		set++
+		kind, caption, parseMode, entities = "audio", m.Audio.Caption, m.Audio.ParseMode, m.Audio.CaptionEntities
+	}
+	if m.Document != nil {
+		set++
+		kind, caption, parseMode, entities = "document", m.Document.Caption, m.Document.ParseMode, m.Document.CaptionEntities
+	}
+	if m.Animation != nil {
+		set++
+		kind, caption, parseMode, entities = "animation", m.Animation.Caption, m.Animation.ParseMode, m.Animation.CaptionEntities
+	}
+	if set != 1 {
+		return "", fmt.Errorf("exactly one of Photo, Video, Audio, Document and Animation must be set")
+	}
+	return kind, validateCaption(caption, parseMode, entities)
+}
+
+// Checks the caption fields shared by all the media methods.
+// Like ParseMode.check, the error has no package prefix: the caller adds it with some context
+func validateCaption(caption string, parseMode ParseMode, entities []MessageEntity) error {
+	if err := checkLength("caption", caption, parseMode, MaxCaptionLength); err != nil {
+		return err
+	}
+	if err := parseMode.check(); err != nil {
+		return err
+	}
+	if parseMode != ParseModeNone && len(entities) > 0 {
+		return fmt.Errorf("parse_mode and caption_entities can't be used together")
+	}
+	return nil
+}
+
+// Validate checks the parameters of editMessageCaption.
+// On success the method returns the edited Message, or True for inline messages
+func (p *EditMessageCaptionParams) Validate() error {
+	if err := p.MessageLocator.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := validateCaption(p.Caption, p.ParseMode, p.CaptionEntities); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Checks the parameters shared by all the send* methods
+func (p *BaseSendParams) validate() error {
+	if p.ChatID.IsZero() {
+		return fmt.Errorf("chat_id is required")
+	}
+	if err := validateMessageThread(p.ChatID, p.MessageThreadID); err != nil {
+		return err
+	}
+	if err := p.ReplyMarkup.validate(); err != nil {
+		return err
+	}
+	return validateMessageEffect(p.ChatID, p.MessageEffectID)
+}
+
+// Supergroups (the only groups that can be forums) have identifiers starting with -100.
+// A negative identifier without that prefix is a basic group, which can't have topics.
+// For usernames and positive identifiers we can't tell, so we let Telegram decide
+func validateMessageThread(chatID ChatID, threadID int64) error {
+	if threadID != 0 && chatID.Username == "" && chatID.ID < 0 && chatID.ID > -1000000000000 {
+		return fmt.Errorf("message_thread_id can't be used in %s, a basic group without topics", chatID)
+	}
+	return nil
+}
+
+// Some methods work only in supergroups, whose identifiers start with -100. Any other identifier
+// is surely not a supergroup: a positive one is a user, a negative one without the prefix a basic group.
+// For usernames we can't tell, so we let Telegram decide
+func validateSupergroup(chatID ChatID) error {
+	if chatID.IsZero() {
+		return fmt.Errorf("chat_id is required")
+	}
+	if chatID.Username == "" && chatID.ID > -1000000000000 {
+		return fmt.Errorf("%s is not a supergroup", chatID)
+	}
+	return nil
+}
+
+// Message effects work only in private chats. Users have positive identifiers,
+// groups and channels negative ones, and only channels and supergroups have a username
+// that can be used as chat_id: anything but a positive ID is surely not a private chat
+func validateMessageEffect(chatID ChatID, effectID string) error {
+	if effectID != "" && (chatID.Username != "" || chatID.ID < 0) {
+		return fmt.Errorf("message effects can only be sent to private chats, not to %s", chatID)
+	}
+	return nil
+}
+
+// Validate checks the parameters of editMessageText.
+// On success the method returns the edited Message, or True for inline messages
+func (p *EditMessageTextParams) Validate() error {
+	if err := p.MessageLocator.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if err := p.ParseMode.Validate(); err != nil {
+		return err
+	}
+	if p.ParseMode != ParseModeNone && len(p.Entities) > 0 {
+		return fmt.Errorf("telegram: parse_mode and entities can't be used together")
+	}
+	if strings.TrimSpace(p.Text) == "" {
+		return fmt.Errorf("telegram: message text is empty")
+	}
+	if err := checkLength("message text", p.Text, p.ParseMode, MaxMessageTextLength); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of editMessageReplyMarkup
+func (p *EditMessageReplyMarkupParams) Validate() error {
+	if err := p.MessageLocator.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of editMessageMedia
+func (p *EditMessageMediaParams) Validate() error {
+	if err := p.MessageLocator.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if _, err := p.Media.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.InlineMessageID != "" && isUpload(&p.Media) {
+		return fmt.Errorf("telegram: new files can't be uploaded when editing an inline message")
+	}
+	return nil
+}
+
+// Reports whether the media is a new file to upload
+func isUpload(m *InputMedia) bool {
+	var media string
+	switch {
+	case m.Photo != nil:
+		media = m.Photo.Media
+	case m.Video != nil:
+		media = m.Video.Media
+	case m.Audio != nil:
+		media = m.Audio.Media
+	case m.Document != nil:
+		media = m.Document.Media
+	case m.Animation != nil:
+		media = m.Animation.Media
+	}
+	return strings.HasPrefix(media, "attach://")
+}
+
+// ByChat locates the message messageID in a chat
+func ByChat(chatID ChatID, messageID int64) MessageLocator {
+	return MessageLocator{ChatID: chatID, MessageID: messageID}
+}
+
+// ByInline locates a message sent via the bot in inline mode
+func ByInline(inlineMessageID string) MessageLocator {
+	return MessageLocator{InlineMessageID: inlineMessageID}
+}
+
+// Checks that exactly one of the two ways is used, and completely
+func (l *MessageLocator) validate() error {
+	byChat := !l.ChatID.IsZero() || l.MessageID != 0
+	byInline := l.InlineMessageID != ""
+	switch {
+	case byChat && byInline:
+		return fmt.Errorf("use either chat_id and message_id or inline_message_id, not both")
+	case byInline:
+		return nil
+	case l.ChatID.IsZero() || l.MessageID == 0:
+		return fmt.Errorf("chat_id and message_id are required when inline_message_id is not specified")
+	}
+	return nil
+}
+
+// The limits on texts are "after entities parsing". Without a parse mode the text
+// is exactly what will be sent, so it can be measured (in UTF-16 units, like Telegram does).
+// With a parse mode the markup would be counted too, so we leave the check to Telegram
+func checkLength(what, text string, parseMode ParseMode, limit int64) error {
+	if parseMode != ParseModeNone {
+		return nil
+	}
+	if n := UTF16Length(text); n > limit {
+		return fmt.Errorf("%s is %d UTF-16 units long, the limit is %d", what, n, limit)
+	}
+	return nil
+}
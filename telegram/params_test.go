@@ -0,0 +1,411 @@
+/* params_test.go : tests for the validation of the method parameters
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSendMessageTextLength(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		parseMode ParseMode
+		wantErr   bool
+	}{
+		{name: "empty", text: "", wantErr: true},
+		{name: "whitespace only", text: " \n\t", wantErr: true},
+		{name: "ascii at the limit", text: strings.Repeat("a", MaxMessageTextLength)},
+		{name: "ascii over the limit", text: strings.Repeat("a", MaxMessageTextLength+1), wantErr: true},
+		// 😀 is a surrogate pair: 2 UTF-16 units
+		{name: "emoji at the limit", text: strings.Repeat("😀", MaxMessageTextLength/2)},
+		{name: "emoji over the limit", text: strings.Repeat("😀", MaxMessageTextLength/2) + "a", wantErr: true},
+		// 2048 runes, well under 4096 runes, but 4097 UTF-16 units
+		{name: "emoji mixed over the limit", text: "é" + strings.Repeat("😀", MaxMessageTextLength/2), wantErr: true},
+		{name: "bmp symbols at the limit", text: strings.Repeat("€", MaxMessageTextLength)},
+		{name: "parse mode left to Telegram", text: strings.Repeat("😀", MaxMessageTextLength), parseMode: ParseModeHTML},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := SendMessageParams{Text: tt.text, ParseMode: tt.parseMode}
+			params.ChatID = ChatID{ID: 42}
+			if err := params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSendRequiresChatID(t *testing.T) {
+	tests := []struct {
+		name    string
+		chatID  ChatID
+		wantErr bool
+	}{
+		{name: "no chat", wantErr: true},
+		{name: "identifier", chatID: NewChatID(42)},
+		{name: "username", chatID: NewChatUsername("channel")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := SendMessageParams{Text: "hi"}
+			message.ChatID = tt.chatID
+			photo := SendPhotoParams{Photo: "photo-1"}
+			photo.ChatID = tt.chatID
+			for _, err := range []error{message.Validate(), photo.Validate()} {
+				if (err != nil) != tt.wantErr {
+					t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestSendAudioValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		thumbnail string
+		wantErr   bool
+	}{
+		{name: "no thumbnail"},
+		{name: "uploaded thumbnail", thumbnail: "attach://cover"},
+		{name: "thumbnail by file_id", thumbnail: "AgACAgIAAxkBAAI", wantErr: true},
+		{name: "thumbnail by URL", thumbnail: "https://example.com/cover.jpg", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := SendAudioParams{Audio: "attach://song", Thumbnail: tt.thumbnail}.WithDuration(215 * time.Second)
+			params.ChatID = NewChatID(7)
+			if err := params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if params.Duration != 215 {
+				t.Errorf("Duration = %d, want 215", params.Duration)
+			}
+		})
+	}
+}
+
+func TestCaptionLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		caption string
+		wantErr bool
+	}{
+		{name: "no caption", caption: ""},
+		{name: "ascii at the limit", caption: strings.Repeat("a", MaxCaptionLength)},
+		{name: "ascii over the limit", caption: strings.Repeat("a", MaxCaptionLength+1), wantErr: true},
+		{name: "emoji at the limit", caption: strings.Repeat("😀", MaxCaptionLength/2)},
+		{name: "emoji over the limit", caption: strings.Repeat("😀", MaxCaptionLength/2+1), wantErr: true},
+		{name: "flags over the limit", caption: strings.Repeat("🇮🇹", MaxCaptionLength/4) + "a", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := SendPhotoParams{Caption: tt.caption}
+			params.ChatID = ChatID{ID: 42}
+			if err := params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSendContactValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  SendContactParams
+		wantErr bool
+	}{
+		{name: "phone and name", params: SendContactParams{PhoneNumber: "+390612345678", FirstName: "Ann"}},
+		{name: "with vCard", params: SendContactParams{PhoneNumber: "+390612345678", FirstName: "Ann", LastName: "Rossi", VCard: "BEGIN:VCARD\nEND:VCARD"}},
+		{name: "no phone", params: SendContactParams{FirstName: "Ann"}, wantErr: true},
+		{name: "blank phone", params: SendContactParams{PhoneNumber: "  ", FirstName: "Ann"}, wantErr: true},
+		{name: "no first name", params: SendContactParams{PhoneNumber: "+390612345678"}, wantErr: true},
+		{name: "vCard at the limit", params: SendContactParams{PhoneNumber: "1", FirstName: "A", VCard: strings.Repeat("x", MaxVCardLength)}},
+		{name: "vCard over the limit", params: SendContactParams{PhoneNumber: "1", FirstName: "A", VCard: strings.Repeat("x", MaxVCardLength+1)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.ChatID = ChatID{ID: 7}
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSupergroup(t *testing.T) {
+	tests := []struct {
+		name    string
+		chatID  ChatID
+		wantErr bool
+	}{
+		{name: "supergroup", chatID: ChatID{ID: -1001234567890}},
+		{name: "smallest supergroup", chatID: ChatID{ID: -1000000000001}},
+		{name: "username", chatID: NewChatUsername("group")},
+		{name: "basic group", chatID: ChatID{ID: -123456789}, wantErr: true},
+		{name: "user", chatID: ChatID{ID: 123456789}, wantErr: true},
+		{name: "zero", chatID: ChatID{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSupergroup(tt.chatID); (err != nil) != tt.wantErr {
+				t.Errorf("validateSupergroup(%v) = %v, wantErr %v", tt.chatID, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSendFlagsJSON(t *testing.T) {
+	sends := map[string]func(base BaseSendParams) any{
+		"sendMessage":    func(base BaseSendParams) any { return SendMessageParams{BaseSendParams: base, Text: "hi"} },
+		"sendMediaGroup": func(base BaseSendParams) any { return SendMediaGroupParams{BaseSendParams: base} },
+		"sendPhoto":      func(base BaseSendParams) any { return SendPhotoParams{BaseSendParams: base, Photo: "p"} },
+		"sendVideo":      func(base BaseSendParams) any { return SendVideoParams{BaseSendParams: base, Video: "v"} },
+		"sendDocument":   func(base BaseSendParams) any { return SendDocumentParams{BaseSendParams: base, Document: "d"} },
+		"sendAudio":      func(base BaseSendParams) any { return SendAudioParams{BaseSendParams: base, Audio: "a"} },
+		"sendVoice":      func(base BaseSendParams) any { return SendVoiceParams{BaseSendParams: base, Voice: "v"} },
+		"sendVideoNote":  func(base BaseSendParams) any { return SendVideoNoteParams{BaseSendParams: base, VideoNote: "n"} },
+		"sendPoll":       func(base BaseSendParams) any { return SendPollParams{BaseSendParams: base, Question: "q"} },
+		"sendContact": func(base BaseSendParams) any {
+			return SendContactParams{BaseSendParams: base, PhoneNumber: "1", FirstName: "A"}
+		},
+		"sendAnimation": func(base BaseSendParams) any { return SendAnimationParams{BaseSendParams: base, Animation: "a"} },
+	}
+	for method, build := range sends {
+		t.Run(method, func(t *testing.T) {
+			for _, set := range []bool{true, false} {
+				data, err := json.Marshal(build(BaseSendParams{ChatID: NewChatID(7), DisableNotification: set, ProtectContent: set}))
+				if err != nil {
+					t.Fatal(err)
+				}
+				var fields map[string]json.RawMessage
+				if err := json.Unmarshal(data, &fields); err != nil {
+					t.Fatal(err)
+				}
+				for _, name := range []string{"disable_notification", "protect_content"} {
+					value, ok := fields[name]
+					if set && string(value) != "true" {
+						t.Errorf("%s = %s in %s, want true", name, value, data)
+					}
+					if !set && ok {
+						t.Errorf("%s sent as false in %s, it must be omitted", name, data)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeProtectedContent(t *testing.T) {
+	for _, want := range []bool{true, false} {
+		data := `{"message_id":1,"date":1700000000,"chat":{"id":-1009,"type":"channel"},"text":"paid"}`
+		if want {
+			data = `{"message_id":1,"date":1700000000,"chat":{"id":-1009,"type":"channel"},"text":"paid","has_protected_content":true}`
+		}
+		var m Message
+		if err := json.Unmarshal([]byte(data), &m); err != nil {
+			t.Fatal(err)
+		}
+		if m.HasProtectedContent != want {
+			t.Errorf("HasProtectedContent = %v from %s", m.HasProtectedContent, data)
+		}
+	}
+}
+
+func TestMessageLocator(t *testing.T) {
+	tests := []struct {
+		name     string
+		locator  MessageLocator
+		wantJSON string
+		wantErr  bool
+	}{
+		{name: "by chat", locator: ByChat(NewChatID(-100), 5), wantJSON: `{"chat_id":-100,"message_id":5}`},
+		{name: "by channel username", locator: ByChat(NewChatUsername("news"), 5), wantJSON: `{"chat_id":"@news","message_id":5}`},
+		{name: "by inline", locator: ByInline("AAEx"), wantJSON: `{"inline_message_id":"AAEx"}`},
+		{name: "nothing", locator: MessageLocator{}, wantErr: true},
+		{name: "chat without message", locator: MessageLocator{ChatID: NewChatID(-100)}, wantErr: true},
+		{name: "message without chat", locator: MessageLocator{MessageID: 5}, wantErr: true},
+		{name: "both", locator: MessageLocator{ChatID: NewChatID(-100), MessageID: 5, InlineMessageID: "AAEx"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.locator.validate(); (err != nil) != tt.wantErr {
+				t.Fatalf("validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := json.Marshal(tt.locator)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.wantJSON {
+				t.Errorf("got %s, want %s", got, tt.wantJSON)
+			}
+		})
+	}
+}
+
+func TestEditParamsLocator(t *testing.T) {
+	locators := map[string]MessageLocator{
+		"by chat":   ByChat(NewChatID(-100), 5),
+		"by inline": ByInline("AAEx"),
+		"invalid":   {},
+	}
+	for name, locator := range locators {
+		t.Run(name, func(t *testing.T) {
+			params := map[string]interface{ Validate() error }{
+				"editMessageText":        &EditMessageTextParams{MessageLocator: locator, Text: "new"},
+				"editMessageCaption":     &EditMessageCaptionParams{MessageLocator: locator, Caption: "new"},
+				"editMessageReplyMarkup": &EditMessageReplyMarkupParams{MessageLocator: locator},
+			}
+			wantErr := name == "invalid"
+			for method, p := range params {
+				if err := p.Validate(); (err != nil) != wantErr {
+					t.Errorf("%s: Validate() = %v, wantErr %v", method, err, wantErr)
+				}
+				if wantErr {
+					continue
+				}
+				data, _ := json.Marshal(p)
+				var fields map[string]json.RawMessage
+				json.Unmarshal(data, &fields)
+				_, hasChat := fields["chat_id"]
+				_, hasInline := fields["inline_message_id"]
+				if hasChat == (locator.InlineMessageID != "") || hasInline != (locator.InlineMessageID != "") {
+					t.Errorf("%s: sent %s for %+v", method, data, locator)
+				}
+			}
+		})
+	}
+}
+
+func TestShowCaptionAboveMediaJSON(t *testing.T) {
+	msg := ByChat(NewChatID(7), 10)
+	media := func(set bool) map[string]InputMedia {
+		return map[string]InputMedia{
+			"photo":     {Photo: &InputMediaPhoto{Media: "p", ShowCaptionAboveMedia: set}},
+			"video":     {Video: &InputMediaVideo{Media: "v", ShowCaptionAboveMedia: set}},
+			"animation": {Animation: &InputMediaAnimation{Media: "a", ShowCaptionAboveMedia: set}},
+		}
+	}
+	bodies := map[string]func(set bool) any{
+		"sendPhoto": func(set bool) any {
+			return SendPhotoParams{BaseSendParams: BaseSendParams{ChatID: NewChatID(7)}, Photo: "p", ShowCaptionAboveMedia: set}
+		},
+		"sendVideo": func(set bool) any {
+			return SendVideoParams{BaseSendParams: BaseSendParams{ChatID: NewChatID(7)}, Video: "v", ShowCaptionAboveMedia: set}
+		},
+		"sendAnimation": func(set bool) any {
+			return SendAnimationParams{BaseSendParams: BaseSendParams{ChatID: NewChatID(7)}, Animation: "a", ShowCaptionAboveMedia: set}
+		},
+		"copyMessage": func(set bool) any {
+			return CopyMessageParams{ChatID: NewChatID(7), FromChatID: NewChatID(8), MessageID: 1, ShowCaptionAboveMedia: set}
+		},
+		"editMessageCaption": func(set bool) any {
+			return EditMessageCaptionParams{MessageLocator: msg, Caption: "c", ShowCaptionAboveMedia: set}
+		},
+		"InputMediaPhoto":     func(set bool) any { return media(set)["photo"] },
+		"InputMediaVideo":     func(set bool) any { return media(set)["video"] },
+		"InputMediaAnimation": func(set bool) any { return media(set)["animation"] },
+	}
+	// editMessageMedia carries the flag inside its media
+	for kind := range media(true) {
+		bodies["editMessageMedia, "+kind] = func(set bool) any {
+			return EditMessageMediaParams{MessageLocator: msg, Media: media(set)[kind]}
+		}
+	}
+
+	for name, build := range bodies {
+		t.Run(name, func(t *testing.T) {
+			for _, set := range []bool{true, false} {
+				data, err := json.Marshal(build(set))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if strings.HasPrefix(name, "editMessageMedia") {
+					var edit struct{ Media json.RawMessage }
+					if err := json.Unmarshal(data, &edit); err != nil {
+						t.Fatal(err)
+					}
+					data = edit.Media
+				}
+				var fields map[string]json.RawMessage
+				if err := json.Unmarshal(data, &fields); err != nil {
+					t.Fatal(err)
+				}
+				value, ok := fields["show_caption_above_media"]
+				if set && string(value) != "true" {
+					t.Errorf("show_caption_above_media = %s in %s, want true", value, data)
+				}
+				if !set && ok {
+					t.Errorf("show_caption_above_media sent as false in %s, it must be omitted", data)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeShowCaptionAboveMedia(t *testing.T) {
+	for _, want := range []bool{true, false} {
+		data := `{"message_id":1,"date":1700000000,"chat":{"id":7,"type":"private"},"photo":[{"file_id":"p","file_unique_id":"u","width":90,"height":90}],"caption":"above"}`
+		if want {
+			data = data[:len(data)-1] + `,"show_caption_above_media":true}`
+		}
+		var m Message
+		if err := json.Unmarshal([]byte(data), &m); err != nil {
+			t.Fatal(err)
+		}
+		if m.ShowCaptionAboveMedia != want {
+			t.Errorf("ShowCaptionAboveMedia = %v from %s", m.ShowCaptionAboveMedia, data)
+		}
+		// Copying the caption of a received message keeps its placement
+		back, err := json.Marshal(CopyMessageParams{ChatID: NewChatID(8), FromChatID: NewChatID(m.Chat.ID), MessageID: m.MessageID, ShowCaptionAboveMedia: m.ShowCaptionAboveMedia})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Contains(string(back), `"show_caption_above_media":true`); got != want {
+			t.Errorf("copyMessage body %s, want the flag %v", back, want)
+		}
+	}
+}
+
+func TestEditMessageMediaValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  EditMessageMediaParams
+		wantErr bool
+	}{
+		{"by chat, file_id", EditMessageMediaParams{MessageLocator: ByChat(NewChatID(7), 10), Media: InputMedia{Animation: &InputMediaAnimation{Media: "a"}}}, false},
+		{"by chat, upload", EditMessageMediaParams{MessageLocator: ByChat(NewChatID(7), 10), Media: InputMedia{Photo: &InputMediaPhoto{Media: "attach://p"}}}, false},
+		{"inline, file_id", EditMessageMediaParams{MessageLocator: ByInline("inl"), Media: InputMedia{Video: &InputMediaVideo{Media: "v"}}}, false},
+		{"inline, upload", EditMessageMediaParams{MessageLocator: ByInline("inl"), Media: InputMedia{Video: &InputMediaVideo{Media: "attach://v"}}}, true},
+		{"no media", EditMessageMediaParams{MessageLocator: ByChat(NewChatID(7), 10)}, true},
+		{"no message", EditMessageMediaParams{Media: InputMedia{Photo: &InputMediaPhoto{Media: "p"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMediaGroupRejectsAnimation(t *testing.T) {
+	params := SendMediaGroupParams{BaseSendParams: BaseSendParams{ChatID: NewChatID(7)}, Media: []InputMedia{
+		{Photo: &InputMediaPhoto{Media: "p"}},
+		{Animation: &InputMediaAnimation{Media: "a"}},
+	}}
+	if err := params.Validate(); err == nil || !strings.Contains(err.Error(), "item 1") {
+		t.Errorf("Validate() = %v, want an error on item 1", err)
+	}
+}
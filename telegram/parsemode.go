@@ -0,0 +1,42 @@
+/* parsemode.go : formatting options
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import "fmt"
+
+// Mode for parsing entities in a text. Telegram wants the exact spelling and
+// silently sends the raw markup if it doesn't recognize it, so this is a typed
+// string with the valid values as constants
+type ParseMode string
+
+const (
+	// No parsing: the text is sent as is (and entities can be passed explicitly)
+	ParseModeNone ParseMode = ""
+
+	ParseModeHTML       ParseMode = "HTML"
+	ParseModeMarkdownV2 ParseMode = "MarkdownV2"
+
+	// Legacy mode, kept by Telegram for backward compatibility. Use ParseModeMarkdownV2 instead
+	ParseModeMarkdown ParseMode = "Markdown"
+)
+
+// Validate returns an error if p is not one of the parse modes Telegram knows
+func (p ParseMode) Validate() error {
+	if err := p.check(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Same as Validate, without the package prefix, for the checks that wrap it
+func (p ParseMode) check() error {
+	switch p {
+	case ParseModeNone, ParseModeHTML, ParseModeMarkdownV2, ParseModeMarkdown:
+		return nil
+	}
+	return fmt.Errorf("unknown parse mode %q", string(p))
+}
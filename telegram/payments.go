@@ -0,0 +1,107 @@
+/* payments.go : payment helpers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SubscriptionExpiration returns the expiration date of the subscription paid with this payment.
+// The boolean is false for payments that are not part of a subscription
+func (p *SuccessfulPayment) SubscriptionExpiration() (time.Time, bool) {
+	if p.SubscriptionExpirationDate == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(p.SubscriptionExpirationDate, 0), true
+}
+
+// Validate checks the parameters of editUserStarSubscription
+func (p *EditUserStarSubscriptionParams) Validate() error {
+	if p.UserID == 0 {
+		return fmt.Errorf("telegram: user_id is required")
+	}
+	if p.TelegramPaymentChargeID == "" {
+		return fmt.Errorf("telegram: telegram_payment_charge_id is required")
+	}
+	return nil
+}
+
+// NewEditUserStarSubscriptionParams returns the parameters to cancel (or re-enable) the
+// subscription paid with payment by the user
+func NewEditUserStarSubscriptionParams(userID int64, payment *SuccessfulPayment, cancel bool) EditUserStarSubscriptionParams {
+	return EditUserStarSubscriptionParams{
+		UserID:                  userID,
+		TelegramPaymentChargeID: payment.TelegramPaymentChargeID,
+		IsCanceled:              cancel,
+	}
+}
+
+// EditUserStarSubscription calls editUserStarSubscription to cancel the subscription of the user,
+// or to re-enable one the bot canceled. Telegram refuses a subscription that is already in the asked
+// state, or that isn't active anymore: its error, an *APIError, is wrapped with the subscription it is about
+func EditUserStarSubscription(ctx context.Context, client *http.Client, token string, userID int64, telegramPaymentChargeID string, isCanceled bool) error {
+	params := EditUserStarSubscriptionParams{UserID: userID, TelegramPaymentChargeID: telegramPaymentChargeID, IsCanceled: isCanceled}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "editUserStarSubscription", &params)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode == 400 {
+		action := "cancel"
+		if !isCanceled {
+			action = "re-enable"
+		}
+		return fmt.Errorf("telegram: can't %s subscription %s of user %d: %w", action, telegramPaymentChargeID, userID, err)
+	}
+	return err
+}
+
+// Validate checks the parameters of answerShippingQuery: shipping options when the query is ok,
+// an error message when it isn't, never both
+func (p *AnswerShippingQueryParams) Validate() error {
+	if p.ShippingQueryID == "" {
+		return fmt.Errorf("telegram: shipping_query_id is required")
+	}
+	if p.Ok {
+		if len(p.ShippingOptions) == 0 {
+			return fmt.Errorf("telegram: shipping_options are required when ok is true")
+		}
+		if p.ErrorMessage != "" {
+			return fmt.Errorf("telegram: error_message can't be set when ok is true")
+		}
+		for i, o := range p.ShippingOptions {
+			if o.ID == "" || o.Title == "" {
+				return fmt.Errorf("telegram: shipping option %d needs an id and a title", i)
+			}
+			if len(o.Prices) == 0 {
+				return fmt.Errorf("telegram: shipping option %q has no prices", o.ID)
+			}
+		}
+		return nil
+	}
+	if p.ErrorMessage == "" {
+		return fmt.Errorf("telegram: error_message is required when ok is false")
+	}
+	if len(p.ShippingOptions) > 0 {
+		return fmt.Errorf("telegram: shipping_options can't be set when ok is false")
+	}
+	return nil
+}
+
+// AnswerShippingQuery calls answerShippingQuery, with the shipping options for the address of the
+// ShippingQuery or the reason why the order can't be delivered there
+func AnswerShippingQuery(ctx context.Context, client *http.Client, token string, params AnswerShippingQueryParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "answerShippingQuery", &params)
+	return err
+}
@@ -0,0 +1,169 @@
+/* payments_test.go : tests for payments
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeStarsSubscriptionPayment(t *testing.T) {
+	data := `{
+		"message_id": 10,
+		"date": 1700000000,
+		"chat": {"id": 7, "type": "private"},
+		"from": {"id": 7, "is_bot": false, "first_name": "Ann"},
+		"successful_payment": {
+			"currency": "XTR",
+			"total_amount": 250,
+			"invoice_payload": "plan-monthly",
+			"subscription_expiration_date": 1702592000,
+			"is_recurring": true,
+			"is_first_recurring": true,
+			"telegram_payment_charge_id": "stxABC",
+			"provider_payment_charge_id": "",
+			"order_info": {"name": "Ann", "email": "ann@example.com"}
+		}
+	}`
+	var m Message
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	p := m.SuccessfulPayment
+	if p == nil {
+		t.Fatal("successful_payment not decoded")
+	}
+	if p.Currency != "XTR" || p.TotalAmount != 250 || p.InvoicePayload != "plan-monthly" {
+		t.Errorf("wrong payment %+v", p)
+	}
+	if !p.IsRecurring || !p.IsFirstRecurring || p.TelegramPaymentChargeID != "stxABC" {
+		t.Errorf("wrong subscription fields %+v", p)
+	}
+	if p.OrderInfo == nil || p.OrderInfo.Email != "ann@example.com" {
+		t.Errorf("wrong order_info %+v", p.OrderInfo)
+	}
+	exp, ok := p.SubscriptionExpiration()
+	if !ok || !exp.Equal(time.Unix(1702592000, 0)) {
+		t.Errorf("SubscriptionExpiration() = %v, %v", exp, ok)
+	}
+
+	edit := NewEditUserStarSubscriptionParams(m.From.ID, p, true)
+	if err := edit.Validate(); err != nil || edit.TelegramPaymentChargeID != "stxABC" || !edit.IsCanceled {
+		t.Errorf("NewEditUserStarSubscriptionParams = %+v, %v", edit, err)
+	}
+}
+
+func TestSubscriptionExpirationOneOff(t *testing.T) {
+	p := SuccessfulPayment{Currency: "EUR", TotalAmount: 999}
+	if _, ok := p.SubscriptionExpiration(); ok {
+		t.Error("a one-off payment has no subscription expiration")
+	}
+}
+
+func TestEditUserStarSubscription(t *testing.T) {
+	tests := []struct {
+		name       string
+		isCanceled bool
+		answer     string
+		wantErr    string // "" if no error
+	}{
+		{name: "cancel", isCanceled: true, answer: `{"ok":true,"result":true}`},
+		{name: "re-enable", isCanceled: false, answer: `{"ok":true,"result":true}`},
+		{
+			name:       "already canceled",
+			isCanceled: true,
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: SUBSCRIPTION_NOT_ACTIVE"}`,
+			wantErr:    "can't cancel subscription stxABC of user 7",
+		},
+		{
+			name:    "other error",
+			answer:  `{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`,
+			wantErr: "403",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent map[string]any
+			client := fakeClient(func(method string, params []byte) string {
+				if method != "editUserStarSubscription" {
+					t.Errorf("method = %s", method)
+				}
+				json.Unmarshal(params, &sent)
+				return tt.answer
+			})
+			err := EditUserStarSubscription(context.Background(), client, "123:abc", 7, "stxABC", tt.isCanceled)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				var apiErr *APIError
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !errors.As(err, &apiErr) {
+					t.Fatalf("error = %v, want one containing %q that wraps the APIError", err, tt.wantErr)
+				}
+			}
+			// false must be sent: it is what re-enables a subscription
+			if canceled, ok := sent["is_canceled"]; !ok || canceled != tt.isCanceled {
+				t.Errorf("is_canceled = %v (present: %v), want %v", canceled, ok, tt.isCanceled)
+			}
+		})
+	}
+
+	if err := EditUserStarSubscription(context.Background(), nil, "123:abc", 7, "", true); err == nil {
+		t.Error("EditUserStarSubscription() without charge id succeeded")
+	}
+}
+
+func TestAnswerShippingQuery(t *testing.T) {
+	options := []ShippingOption{{ID: "post", Title: "Post", Prices: []LabeledPrice{{Label: "Shipping", Amount: 500}}}}
+	tests := []struct {
+		name       string
+		params     AnswerShippingQueryParams
+		wantParams string
+		wantErr    bool
+	}{
+		{
+			name:       "ok",
+			params:     AnswerShippingQueryParams{ShippingQueryID: "sq1", Ok: true, ShippingOptions: options},
+			wantParams: `{"shipping_query_id":"sq1","ok":true,"shipping_options":[{"id":"post","title":"Post","prices":[{"label":"Shipping","amount":500}]}]}`,
+		},
+		{
+			name:       "error",
+			params:     AnswerShippingQueryParams{ShippingQueryID: "sq1", ErrorMessage: "We don't ship there"},
+			wantParams: `{"shipping_query_id":"sq1","ok":false,"error_message":"We don't ship there"}`,
+		},
+		{name: "ok without options", params: AnswerShippingQueryParams{ShippingQueryID: "sq1", Ok: true}, wantErr: true},
+		{name: "both", params: AnswerShippingQueryParams{ShippingQueryID: "sq1", Ok: true, ShippingOptions: options, ErrorMessage: "no"}, wantErr: true},
+		{name: "neither", params: AnswerShippingQueryParams{ShippingQueryID: "sq1"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod, gotParams string
+			client := fakeClient(func(method string, params []byte) string {
+				gotMethod, gotParams = method, string(params)
+				return `{"ok":true,"result":true}`
+			})
+			err := AnswerShippingQuery(context.Background(), client, "123:abc", tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AnswerShippingQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if gotMethod != "" {
+					t.Errorf("invalid parameters were sent to %s", gotMethod)
+				}
+				return
+			}
+			if gotMethod != "answerShippingQuery" || gotParams != tt.wantParams {
+				t.Errorf("called %s with %s, want %s", gotMethod, gotParams, tt.wantParams)
+			}
+		})
+	}
+}
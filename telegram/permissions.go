@@ -0,0 +1,181 @@
+/* permissions.go : permissions of the members of a chat
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * ChatPermissions has one flag per kind of content. Unless
+ * use_independent_chat_permissions is passed, Telegram derives some of
+ * them from the others, so what a user ends up with may not be what was sent.
+ */
+
+package telegram
+
+import "fmt"
+
+// MutePermissions returns the permissions of a muted user: nothing at all.
+// All the flags are false, so they are omitted and the JSON is {}, which Telegram reads as such
+func MutePermissions() ChatPermissions {
+	return ChatPermissions{}
+}
+
+// ReadOnly returns the permissions of a user that can read the chat and invite others to it,
+// but can't send anything
+func ReadOnly() ChatPermissions {
+	return ChatPermissions{CanInviteUsers: true}
+}
+
+// FullPermissions returns every permission, the ones to pass to restrictChatMember to lift all restrictions
+func FullPermissions() ChatPermissions {
+	return NewPermissions().Text().Media().Polls().Other().WebPagePreviews().
+		ChangeInfo().InviteUsers().PinMessages().ManageTopics().Build()
+}
+
+// Effective returns the permissions a user gets when p is sent to restrictChatMember.
+// If independent (use_independent_chat_permissions) they are p itself; otherwise can_send_other_messages
+// and can_add_web_page_previews grant also text and every kind of media, and can_send_polls grants also text
+func (p ChatPermissions) Effective(independent bool) ChatPermissions {
+	if independent {
+		return p
+	}
+	if p.CanSendOtherMessages || p.CanAddWebPagePreviews {
+		p.CanSendMessages = true
+		p.CanSendAudios, p.CanSendDocuments, p.CanSendPhotos, p.CanSendVideos = true, true, true, true
+		p.CanSendVideoNotes, p.CanSendVoiceNotes = true, true
+	}
+	if p.CanSendPolls {
+		p.CanSendMessages = true
+	}
+	return p
+}
+
+// PermissionsBuilder builds a ChatPermissions starting from no permission at all:
+//
+//	NewPermissions().Text().Photos().Build()
+type PermissionsBuilder struct {
+	p ChatPermissions
+}
+
+// NewPermissions starts from the permissions of a muted user
+func NewPermissions() *PermissionsBuilder {
+	return &PermissionsBuilder{}
+}
+
+// Text allows text messages, contacts, giveaways, invoices, locations and venues
+func (b *PermissionsBuilder) Text() *PermissionsBuilder {
+	b.p.CanSendMessages = true
+	return b
+}
+
+// Media allows every kind of media: audios, documents, photos, videos, video notes and voice notes
+func (b *PermissionsBuilder) Media() *PermissionsBuilder {
+	return b.Audios().Documents().Photos().Videos().VideoNotes().VoiceNotes()
+}
+
+// Audios allows audios
+func (b *PermissionsBuilder) Audios() *PermissionsBuilder {
+	b.p.CanSendAudios = true
+	return b
+}
+
+// Documents allows documents
+func (b *PermissionsBuilder) Documents() *PermissionsBuilder {
+	b.p.CanSendDocuments = true
+	return b
+}
+
+// Photos allows photos
+func (b *PermissionsBuilder) Photos() *PermissionsBuilder {
+	b.p.CanSendPhotos = true
+	return b
+}
+
+// Videos allows videos
+func (b *PermissionsBuilder) Videos() *PermissionsBuilder {
+	b.p.CanSendVideos = true
+	return b
+}
+
+// VideoNotes allows video notes
+func (b *PermissionsBuilder) VideoNotes() *PermissionsBuilder {
+	b.p.CanSendVideoNotes = true
+	return b
+}
+
+// VoiceNotes allows voice notes
+func (b *PermissionsBuilder) VoiceNotes() *PermissionsBuilder {
+	b.p.CanSendVoiceNotes = true
+	return b
+}
+
+// Polls allows polls and checklists
+func (b *PermissionsBuilder) Polls() *PermissionsBuilder {
+	b.p.CanSendPolls = true
+	return b
+}
+
+// Other allows animations, games, stickers and inline bots
+func (b *PermissionsBuilder) Other() *PermissionsBuilder {
+	b.p.CanSendOtherMessages = true
+	return b
+}
+
+// WebPagePreviews allows link previews
+func (b *PermissionsBuilder) WebPagePreviews() *PermissionsBuilder {
+	b.p.CanAddWebPagePreviews = true
+	return b
+}
+
+// ChangeInfo allows changing the title, the photo and the other settings of the chat
+func (b *PermissionsBuilder) ChangeInfo() *PermissionsBuilder {
+	b.p.CanChangeInfo = true
+	return b
+}
+
+// InviteUsers allows inviting new users
+func (b *PermissionsBuilder) InviteUsers() *PermissionsBuilder {
+	b.p.CanInviteUsers = true
+	return b
+}
+
+// PinMessages allows pinning messages
+func (b *PermissionsBuilder) PinMessages() *PermissionsBuilder {
+	b.p.CanPinMessages = true
+	return b
+}
+
+// ManageTopics allows creating forum topics
+func (b *PermissionsBuilder) ManageTopics() *PermissionsBuilder {
+	b.p.CanManageTopics = true
+	return b
+}
+
+// Build returns the permissions
+func (b *PermissionsBuilder) Build() ChatPermissions {
+	return b.p
+}
+
+// NewRestriction returns the parameters to give the user exactly the permissions p in the chat.
+// use_independent_chat_permissions is set only when needed, that is when Telegram would otherwise
+// grant more than p (see ChatPermissions.Effective)
+func NewRestriction(chatID ChatID, userID int64, p ChatPermissions) RestrictChatMemberParams {
+	return RestrictChatMemberParams{
+		ChatID:                        chatID,
+		UserID:                        userID,
+		Permissions:                   p,
+		UseIndependentChatPermissions: p.Effective(false) != p,
+	}
+}
+
+// Validate checks the parameters of restrictChatMember
+func (p *RestrictChatMemberParams) Validate() error {
+	if err := validateSupergroup(p.ChatID); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if p.UserID <= 0 {
+		return fmt.Errorf("telegram: invalid user_id %d", p.UserID)
+	}
+	if err := validateUntilDate(p.UntilDate); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+/* permissions_test.go : tests for the permissions of the members of a chat
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPermissionsJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions ChatPermissions
+		want        string
+	}{
+		{name: "mute", permissions: MutePermissions(), want: `{}`},
+		{name: "read only", permissions: ReadOnly(), want: `{"can_invite_users":true}`},
+		{name: "text and photos", permissions: NewPermissions().Text().Photos().Build(), want: `{"can_send_messages":true,"can_send_photos":true}`},
+		{
+			name:        "media",
+			permissions: NewPermissions().Media().Build(),
+			want:        `{"can_send_audios":true,"can_send_documents":true,"can_send_photos":true,"can_send_videos":true,"can_send_video_notes":true,"can_send_voice_notes":true}`,
+		},
+		{name: "polls only", permissions: NewPermissions().Polls().Build(), want: `{"can_send_polls":true}`},
+		{
+			name:        "full",
+			permissions: FullPermissions(),
+			want: `{"can_send_messages":true,"can_send_audios":true,"can_send_documents":true,"can_send_photos":true,` +
+				`"can_send_videos":true,"can_send_video_notes":true,"can_send_voice_notes":true,"can_send_polls":true,` +
+				`"can_send_other_messages":true,"can_add_web_page_previews":true,"can_change_info":true,` +
+				`"can_invite_users":true,"can_pin_messages":true,"can_manage_topics":true}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.permissions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRestrictionIndependent(t *testing.T) {
+	tests := []struct {
+		name            string
+		permissions     ChatPermissions
+		wantIndependent bool
+	}{
+		{name: "mute", permissions: MutePermissions()},
+		{name: "full", permissions: FullPermissions()},
+		{name: "text only", permissions: NewPermissions().Text().Build()},
+		// Without the flag, stickers would also grant text and all the media
+		{name: "stickers without text", permissions: NewPermissions().Other().Build(), wantIndependent: true},
+		{name: "link previews without media", permissions: NewPermissions().Text().WebPagePreviews().Build(), wantIndependent: true},
+		// Without the flag, polls would also grant text
+		{name: "polls without text", permissions: NewPermissions().Polls().Build(), wantIndependent: true},
+		{name: "polls with text", permissions: NewPermissions().Text().Polls().Build()},
+		{name: "photos only", permissions: NewPermissions().Photos().Build()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := NewRestriction(NewChatID(-1001234567890), 7, tt.permissions)
+			if params.UseIndependentChatPermissions != tt.wantIndependent {
+				t.Errorf("UseIndependentChatPermissions = %v, want %v", params.UseIndependentChatPermissions, tt.wantIndependent)
+			}
+			if got := params.Permissions.Effective(params.UseIndependentChatPermissions); got != tt.permissions {
+				t.Errorf("the user would get %+v, want %+v", got, tt.permissions)
+			}
+			if err := params.Validate(); err != nil {
+				t.Errorf("Validate() = %v", err)
+			}
+
+			data, err := json.Marshal(params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var sent map[string]json.RawMessage
+			if err := json.Unmarshal(data, &sent); err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := sent["use_independent_chat_permissions"]; ok != tt.wantIndependent {
+				t.Errorf("use_independent_chat_permissions sent: %v, want %v (%s)", ok, tt.wantIndependent, data)
+			}
+			if _, ok := sent["permissions"]; !ok {
+				t.Errorf("permissions not sent: %s", data)
+			}
+		})
+	}
+}
+
+func TestRestrictChatMemberValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  RestrictChatMemberParams
+		wantErr bool
+	}{
+		{name: "supergroup", params: NewRestriction(NewChatID(-1001234567890), 7, MutePermissions())},
+		{name: "basic group", params: NewRestriction(NewChatID(-123456789), 7, MutePermissions()), wantErr: true},
+		{name: "no user", params: NewRestriction(NewChatID(-1001234567890), 0, MutePermissions()), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
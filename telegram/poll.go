@@ -0,0 +1,140 @@
+/* poll.go : client-side checks on polls
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Limits on the number of options of a poll
+const (
+	MinPollOptions = 2
+	MaxPollOptions = 12
+)
+
+// Maximum length of the text of a poll option, in characters
+const MaxPollOptionLength = 100
+
+// Limits on the question and the explanation of a quiz, in characters
+const (
+	MaxPollQuestionLength    = 300
+	MaxPollExplanationLength = 200
+	MaxPollExplanationLines  = 3 // i.e. at most 2 line feeds
+)
+
+// Limits on how long a poll stays open, with open_period or close_date
+const (
+	MinPollOpenPeriod = 5 * time.Second
+	MaxPollOpenPeriod = 600 * time.Second
+)
+
+// NewPollOptions returns the options of a poll with the given texts, without formatting
+func NewPollOptions(texts ...string) []InputPollOption {
+	options := make([]InputPollOption, len(texts))
+	for i, text := range texts {
+		options[i] = InputPollOption{Text: text}
+	}
+	return options
+}
+
+// Validate checks the parameters of sendPoll. The most common mistake is the
+// correct_option_id of a quiz: it is required for quizzes, must point to one of
+// the options and makes no sense for regular polls
+func (p *SendPollParams) Validate() error {
+	if err := p.BaseSendParams.validate(); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	if n := utf8.RuneCountInString(p.Question); strings.TrimSpace(p.Question) == "" || n > MaxPollQuestionLength {
+		return fmt.Errorf("telegram: poll question must be 1-%d characters, got %d", MaxPollQuestionLength, n)
+	}
+	if n := len(p.Options); n < MinPollOptions || n > MaxPollOptions {
+		return fmt.Errorf("telegram: a poll must have %d-%d options, got %d", MinPollOptions, MaxPollOptions, n)
+	}
+
+	for i, option := range p.Options {
+		if err := option.validate(); err != nil {
+			return fmt.Errorf("telegram: poll option %d: %w", i, err)
+		}
+	}
+
+	switch p.Type {
+	case "quiz":
+		if p.CorrectOptionID == nil {
+			return fmt.Errorf("telegram: a quiz needs correct_option_id")
+		}
+		if id := *p.CorrectOptionID; id < 0 || id >= int64(len(p.Options)) {
+			return fmt.Errorf("telegram: correct_option_id %d is out of range, the quiz has %d options", id, len(p.Options))
+		}
+	case "", "regular":
+		if p.CorrectOptionID != nil {
+			return fmt.Errorf("telegram: correct_option_id can only be set on a quiz")
+		}
+		if p.Explanation != "" || len(p.ExplanationEntities) > 0 {
+			return fmt.Errorf("telegram: an explanation can only be set on a quiz")
+		}
+	default:
+		return fmt.Errorf("telegram: unknown poll type %q", p.Type)
+	}
+
+	if err := p.ExplanationParseMode.Validate(); err != nil {
+		return err
+	}
+	if p.ExplanationParseMode != ParseModeNone && len(p.ExplanationEntities) > 0 {
+		return fmt.Errorf("telegram: explanation_parse_mode and explanation_entities can't be used together")
+	}
+	// Same as the captions: with a parse mode the markup would be counted too
+	if p.ExplanationParseMode == ParseModeNone {
+		if n := utf8.RuneCountInString(p.Explanation); n > MaxPollExplanationLength {
+			return fmt.Errorf("telegram: explanation is %d characters long, the limit is %d", n, MaxPollExplanationLength)
+		}
+		if lines := strings.Count(p.Explanation, "\n") + 1; lines > MaxPollExplanationLines {
+			return fmt.Errorf("telegram: explanation can have at most %d line feeds", MaxPollExplanationLines-1)
+		}
+	}
+
+	return p.validatePeriod(time.Now())
+}
+
+// Checks open_period and close_date, which are two ways of saying the same thing.
+// now is a parameter because close_date is an absolute time
+func (p *SendPollParams) validatePeriod(now time.Time) error {
+	if p.OpenPeriod != 0 && p.CloseDate != 0 {
+		return fmt.Errorf("telegram: open_period and close_date can't be used together")
+	}
+	minPeriod, maxPeriod := int64(MinPollOpenPeriod/time.Second), int64(MaxPollOpenPeriod/time.Second)
+	if p.OpenPeriod != 0 && (p.OpenPeriod < minPeriod || p.OpenPeriod > maxPeriod) {
+		return fmt.Errorf("telegram: open_period must be %d-%d seconds, got %d", minPeriod, maxPeriod, p.OpenPeriod)
+	}
+	if p.CloseDate != 0 {
+		if in := p.CloseDate - now.Unix(); in < minPeriod || in > maxPeriod {
+			return fmt.Errorf("telegram: close_date must be %d-%d seconds in the future, it is %d", minPeriod, maxPeriod, in)
+		}
+	}
+	return nil
+}
+
+// Checks the text of an option. As usual, with a parse mode the markup is counted too,
+// so the length is checked only for plain text
+func (o *InputPollOption) validate() error {
+	if o.TextParseMode == ParseModeNone {
+		if n := utf8.RuneCountInString(o.Text); n < 1 || n > MaxPollOptionLength {
+			return fmt.Errorf("text must be 1-%d characters, got %d", MaxPollOptionLength, n)
+		}
+	} else if o.Text == "" {
+		return fmt.Errorf("text is empty")
+	}
+	if err := o.TextParseMode.check(); err != nil {
+		return err
+	}
+	if o.TextParseMode != ParseModeNone && len(o.TextEntities) > 0 {
+		return fmt.Errorf("text_parse_mode and text_entities can't be used together")
+	}
+	return nil
+}
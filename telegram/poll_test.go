@@ -0,0 +1,92 @@
+/* poll_test.go : tests for the validation of polls
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestPoll() SendPollParams {
+	p := SendPollParams{Question: "Tabs or spaces?", Options: []InputPollOption{{Text: "Tabs"}, {Text: "Spaces"}}}
+	p.ChatID = ChatID{ID: -1001234567890}
+	return p
+}
+
+func TestSendPollValidate(t *testing.T) {
+	correct := int64(1)
+	tests := []struct {
+		name    string
+		edit    func(p *SendPollParams)
+		wantErr bool
+	}{
+		{name: "valid", edit: func(p *SendPollParams) {}},
+		{name: "empty question", edit: func(p *SendPollParams) { p.Question = " " }, wantErr: true},
+		{name: "question at the limit", edit: func(p *SendPollParams) { p.Question = strings.Repeat("😀", MaxPollQuestionLength) }},
+		{name: "question over the limit", edit: func(p *SendPollParams) { p.Question = strings.Repeat("a", MaxPollQuestionLength+1) }, wantErr: true},
+		{name: "open period at the minimum", edit: func(p *SendPollParams) { p.OpenPeriod = 5 }},
+		{name: "open period under the minimum", edit: func(p *SendPollParams) { p.OpenPeriod = 4 }, wantErr: true},
+		{name: "open period at the maximum", edit: func(p *SendPollParams) { p.OpenPeriod = 600 }},
+		{name: "open period over the maximum", edit: func(p *SendPollParams) { p.OpenPeriod = 601 }, wantErr: true},
+		{name: "close date and open period", edit: func(p *SendPollParams) { p.OpenPeriod = 60; p.CloseDate = time.Now().Unix() + 60 }, wantErr: true},
+		{name: "close date in a minute", edit: func(p *SendPollParams) { p.CloseDate = time.Now().Unix() + 60 }},
+		{name: "close date in the past", edit: func(p *SendPollParams) { p.CloseDate = time.Now().Unix() - 60 }, wantErr: true},
+		{name: "close date too far", edit: func(p *SendPollParams) { p.CloseDate = time.Now().Unix() + 3600 }, wantErr: true},
+		{
+			name: "quiz explanation at the limit",
+			edit: func(p *SendPollParams) {
+				p.Type, p.CorrectOptionID, p.Explanation = "quiz", &correct, strings.Repeat("é", MaxPollExplanationLength)
+			},
+		},
+		{
+			name: "quiz explanation over the limit",
+			edit: func(p *SendPollParams) {
+				p.Type, p.CorrectOptionID, p.Explanation = "quiz", &correct, strings.Repeat("é", MaxPollExplanationLength+1)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "quiz explanation with 3 line feeds",
+			edit:    func(p *SendPollParams) { p.Type, p.CorrectOptionID, p.Explanation = "quiz", &correct, "a\nb\nc\nd" },
+			wantErr: true,
+		},
+		{name: "quiz without correct option", edit: func(p *SendPollParams) { p.Type = "quiz" }, wantErr: true},
+		{name: "explanation on a regular poll", edit: func(p *SendPollParams) { p.Explanation = "because" }, wantErr: true},
+		{name: "one option", edit: func(p *SendPollParams) { p.Options = p.Options[:1] }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPoll()
+			tt.edit(&p)
+			if err := p.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// close_date is checked against a given time, so its boundaries can be tested exactly
+func TestSendPollCloseDateBoundaries(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		in      int64
+		wantErr bool
+	}{
+		{in: 4, wantErr: true},
+		{in: 5},
+		{in: 600},
+		{in: 601, wantErr: true},
+	}
+	for _, tt := range tests {
+		p := newTestPoll()
+		p.CloseDate = now.Unix() + tt.in
+		if err := p.validatePeriod(now); (err != nil) != tt.wantErr {
+			t.Errorf("close_date in %ds: %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+	}
+}
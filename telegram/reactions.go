@@ -0,0 +1,122 @@
+/* reactions.go : message reactions
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * A reaction can't be any emoji: Telegram accepts only the list below and
+ * answers REACTION_INVALID to everything else.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Some of the emoji accepted as reactions, for the common cases
+const (
+	ReactionThumbsUp   = "👍"
+	ReactionThumbsDown = "👎"
+	ReactionHeart      = "❤"
+	ReactionFire       = "🔥"
+	ReactionClap       = "👏"
+	ReactionGrin       = "😁"
+	ReactionThinking   = "🤔"
+	ReactionParty      = "🎉"
+	ReactionPray       = "🙏"
+	ReactionOK         = "👌"
+	ReactionHundred    = "💯"
+	ReactionEyes       = "👀"
+	ReactionHandshake  = "🤝"
+)
+
+// All the emoji accepted by ReactionTypeEmoji, as documented by Telegram
+var reactionEmoji = map[string]bool{
+	"❤": true, "👍": true, "👎": true, "🔥": true, "🥰": true, "👏": true, "😁": true, "🤔": true,
+	"🤯": true, "😱": true, "🤬": true, "😢": true, "🎉": true, "🤩": true, "🤮": true, "💩": true,
+	"🙏": true, "👌": true, "🕊": true, "🤡": true, "🥱": true, "🥴": true, "😍": true, "🐳": true,
+	"❤‍🔥": true, "🌚": true, "🌭": true, "💯": true, "🤣": true, "⚡": true, "🍌": true, "🏆": true,
+	"💔": true, "🤨": true, "😐": true, "🍓": true, "🍾": true, "💋": true, "🖕": true, "😈": true,
+	"😴": true, "😭": true, "🤓": true, "👻": true, "👨‍💻": true, "👀": true, "🎃": true, "🙈": true,
+	"😇": true, "😨": true, "🤝": true, "✍": true, "🤗": true, "🫡": true, "🎅": true, "🎄": true,
+	"☃": true, "💅": true, "🤪": true, "🗿": true, "🆒": true, "💘": true, "🙉": true, "🦄": true,
+	"😘": true, "💊": true, "🙊": true, "😎": true, "👾": true, "🤷‍♂": true, "🤷": true, "🤷‍♀": true,
+	"😡": true,
+}
+
+// IsReactionEmoji reports whether Telegram accepts emoji as a reaction
+func IsReactionEmoji(emoji string) bool {
+	return reactionEmoji[emoji]
+}
+
+// NewEmojiReaction returns the ReactionType for an emoji, or an error if the emoji
+// can't be used as a reaction
+func NewEmojiReaction(emoji string) (ReactionType, error) {
+	if !IsReactionEmoji(emoji) {
+		return ReactionType{}, fmt.Errorf("telegram: %q can't be used as a reaction", emoji)
+	}
+	return ReactionType{Emoji: &ReactionTypeEmoji{Emoji: emoji}}, nil
+}
+
+// NewReactionParams returns the parameters to react to a message with an emoji:
+// the one-liner for a 👍. Pass an empty emoji to remove the reaction of the bot
+func NewReactionParams(chatID ChatID, messageID int64, emoji string) (SetMessageReactionParams, error) {
+	params := SetMessageReactionParams{ChatID: chatID, MessageID: messageID}
+	if emoji == "" {
+		return params, nil
+	}
+	reaction, err := NewEmojiReaction(emoji)
+	if err != nil {
+		return SetMessageReactionParams{}, err
+	}
+	params.Reaction = []ReactionType{reaction}
+	return params, nil
+}
+
+// Validate checks the parameters of setMessageReaction: emoji must be in the accepted
+// list and bots can't send paid reactions
+func (p *SetMessageReactionParams) Validate() error {
+	for i, r := range p.Reaction {
+		switch {
+		case r.Emoji != nil:
+			if !IsReactionEmoji(r.Emoji.Emoji) {
+				return fmt.Errorf("telegram: reaction %d: %q can't be used as a reaction", i, r.Emoji.Emoji)
+			}
+		case r.CustomEmoji != nil:
+		case r.Paid != nil:
+			return fmt.Errorf("telegram: reaction %d: bots can't set paid reactions", i)
+		default:
+			return fmt.Errorf("telegram: reaction %d: no member of ReactionType is set", i)
+		}
+	}
+	return nil
+}
+
+// SetMessageReaction calls setMessageReaction
+func SetMessageReaction(ctx context.Context, client *http.Client, token string, params SetMessageReactionParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setMessageReaction", &params)
+	return err
+}
+
+// React sets the reaction of the bot on a message to emoji, which must be one of the accepted ones
+// (see the Reaction constants). It is the one-liner for a 👍
+func React(ctx context.Context, client *http.Client, token string, chatID ChatID, messageID int64, emoji string) error {
+	if emoji == "" {
+		return fmt.Errorf("telegram: empty reaction, use Unreact to remove it")
+	}
+	params, err := NewReactionParams(chatID, messageID, emoji)
+	if err != nil {
+		return err
+	}
+	return SetMessageReaction(ctx, client, token, params)
+}
+
+// Unreact removes the reactions of the bot from a message
+func Unreact(ctx context.Context, client *http.Client, token string, chatID ChatID, messageID int64) error {
+	return SetMessageReaction(ctx, client, token, SetMessageReactionParams{ChatID: chatID, MessageID: messageID})
+}
@@ -0,0 +1,61 @@
+/* response.go : decoding the answers of the Bot API
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Every answer is a JSON object like {"ok": true, "result": ...} or
+ * {"ok": false, "error_code": ..., "description": ...}. Many methods have
+ * just True as result: not "something truthy", the JSON literal true.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The JSON object of every answer of the Bot API
+type apiResponse struct {
+	Ok     bool            `json:"ok"`
+	Result json.RawMessage `json:"result"`
+	APIError
+}
+
+// DecodeResponse decodes the answer of a Bot API method. On success, the "result"
+// field is decoded into result (which can be nil to ignore it). On failure, the
+// returned error is an *APIError
+func DecodeResponse(body []byte, result any) error {
+	var resp apiResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("telegram: decoding response: %w", err)
+	}
+	if !resp.Ok {
+		apiErr := resp.APIError
+		return &apiErr
+	}
+	if result == nil {
+		return nil
+	}
+	if len(resp.Result) == 0 {
+		return fmt.Errorf("telegram: response has no result")
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		return fmt.Errorf("telegram: decoding result: %w", err)
+	}
+	return nil
+}
+
+// DecodeBoolResponse decodes the answer of a method that returns True on success.
+// It returns true only for {"ok": true, "result": true}: anything else is an error,
+// because a method of this kind never answers false when it succeeds
+func DecodeBoolResponse(body []byte) (bool, error) {
+	var result json.RawMessage
+	if err := DecodeResponse(body, &result); err != nil {
+		return false, err
+	}
+	if string(result) != "true" {
+		return false, fmt.Errorf("telegram: expected true as result, got %s", result)
+	}
+	return true, nil
+}
@@ -0,0 +1,153 @@
+/* rights.go : administrator rights
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Has tells if the rights include the one named right, using the names of the Bot API
+// (e.g. "can_delete_messages"), so a bot can skip the calls it would fail anyway.
+// It returns an error for names that aren't administrator rights
+func (r *ChatAdministratorRights) Has(right string) (bool, error) {
+	switch right {
+	case "is_anonymous":
+		return r.IsAnonymous, nil
+	case "can_manage_chat":
+		return r.CanManageChat, nil
+	case "can_delete_messages":
+		return r.CanDeleteMessages, nil
+	case "can_manage_video_chats":
+		return r.CanManageVideoChats, nil
+	case "can_restrict_members":
+		return r.CanRestrictMembers, nil
+	case "can_promote_members":
+		return r.CanPromoteMembers, nil
+	case "can_change_info":
+		return r.CanChangeInfo, nil
+	case "can_invite_users":
+		return r.CanInviteUsers, nil
+	case "can_post_stories":
+		return r.CanPostStories, nil
+	case "can_edit_stories":
+		return r.CanEditStories, nil
+	case "can_delete_stories":
+		return r.CanDeleteStories, nil
+	case "can_post_messages":
+		return r.CanPostMessages, nil
+	case "can_edit_messages":
+		return r.CanEditMessages, nil
+	case "can_pin_messages":
+		return r.CanPinMessages, nil
+	case "can_manage_topics":
+		return r.CanManageTopics, nil
+	case "can_manage_direct_messages":
+		return r.CanManageDirectMessages, nil
+	}
+	return false, fmt.Errorf("telegram: unknown administrator right %q", right)
+}
+
+// Has tells if the member has the named right, like ChatAdministratorRights.Has. The owner has all of them,
+// and members that are not administrators have none: for them the result is false, without an error
+func (m *ChatMember) Has(right string) (bool, error) {
+	var none ChatAdministratorRights
+	if _, err := none.Has(right); err != nil {
+		return false, err
+	}
+	switch {
+	case m.Owner != nil:
+		if right == "is_anonymous" {
+			return m.Owner.IsAnonymous, nil
+		}
+		return true, nil
+	case m.Administrator != nil:
+		return m.Administrator.Has(right)
+	}
+	return false, nil
+}
+
+// GetChatMember calls getChatMember
+func GetChatMember(ctx context.Context, client *http.Client, token string, params GetChatMemberParams) (*ChatMember, error) {
+	if params.ChatID.IsZero() || params.UserID == 0 {
+		return nil, fmt.Errorf("telegram: chat_id and user_id are required")
+	}
+	member, err := Call[ChatMember](ctx, client, token, "getChatMember", &params)
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// BotMember returns the bot itself as a member of the chat, calling getMe and then getChatMember
+func BotMember(ctx context.Context, client *http.Client, token string, chatID ChatID) (*ChatMember, error) {
+	me, err := Call[User](ctx, client, token, "getMe", nil)
+	if err != nil {
+		return nil, err
+	}
+	return GetChatMember(ctx, client, token, GetChatMemberParams{ChatID: chatID, UserID: me.ID})
+}
+
+// CanI tells if the bot has the named right in the chat (e.g. "can_delete_messages"), see ChatMember.Has.
+// It makes two calls every time: to ask often, e.g. before every delete, use a RightsCache
+func CanI(ctx context.Context, client *http.Client, token string, chatID ChatID, right string) (bool, error) {
+	member, err := BotMember(ctx, client, token, chatID)
+	if err != nil {
+		return false, err
+	}
+	return member.Has(right)
+}
+
+// How long RightsCache keeps the rights of the bot in a chat by default
+const DefaultRightsCacheTTL = time.Minute
+
+// The function that gets the bot as a member of a chat, usually BotMember
+type BotMemberFetcher func(ctx context.Context, chatID ChatID) (*ChatMember, error)
+
+// RightsCache answers CanI from the rights of the bot fetched a short while ago. Pass it every update
+// with HandleUpdate so that a promotion or demotion of the bot is seen at once. It is safe for concurrent use
+type RightsCache struct {
+	fetch BotMemberFetcher
+	chats *ttlCache[string, *ChatMember]
+}
+
+// NewRightsCache returns a RightsCache that calls fetch for the chats it doesn't have, and keeps them for ttl
+// (DefaultRightsCacheTTL if ttl is 0)
+func NewRightsCache(ttl time.Duration, fetch BotMemberFetcher) *RightsCache {
+	return &RightsCache{fetch: fetch, chats: newTTLCache[string, *ChatMember](ttl, DefaultRightsCacheTTL)}
+}
+
+// CanI tells if the bot has the named right in the chat, like the CanI function
+func (c *RightsCache) CanI(ctx context.Context, chatID ChatID, right string) (bool, error) {
+	key := chatID.String()
+	if member, ok := c.chats.get(key); ok {
+		return member.Has(right)
+	}
+
+	member, err := c.fetch(ctx, chatID)
+	if err != nil {
+		return false, err
+	}
+	c.chats.set(key, member)
+	return member.Has(right)
+}
+
+// Invalidate forgets the rights of the bot in the chat
+func (c *RightsCache) Invalidate(chatID ChatID) {
+	c.chats.delete(chatID.String())
+}
+
+// HandleUpdate invalidates the chat of a my_chat_member update, which reports a change of the bot's own status
+// (only under its identifier: a chat cached by username expires after the ttl).
+// Other updates are ignored
+func (c *RightsCache) HandleUpdate(u *Update) {
+	if u.MyChatMember != nil {
+		c.Invalidate(NewChatID(u.MyChatMember.Chat.ID))
+	}
+}
@@ -0,0 +1,96 @@
+/* rights_test.go : tests for the administrator rights
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+// A fake Bot API where the bot (id 42) has the given status in every chat
+func fakeBotMember(member string, calls *int) func(method string, params []byte) string {
+	return func(method string, params []byte) string {
+		*calls++
+		switch method {
+		case "getMe":
+			return `{"ok":true,"result":{"id":42,"is_bot":true,"first_name":"Bot"}}`
+		case "getChatMember":
+			var p GetChatMemberParams
+			if err := json.Unmarshal(params, &p); err != nil || p.UserID != 42 {
+				return `{"ok":false,"error_code":400,"description":"Bad Request: wrong user"}`
+			}
+			return `{"ok":true,"result":` + member + `}`
+		}
+		return `{"ok":false,"error_code":404,"description":"Not Found"}`
+	}
+}
+
+func TestCanI(t *testing.T) {
+	const (
+		owner  = `{"status":"creator","user":{"id":42,"is_bot":true,"first_name":"Bot"},"is_anonymous":false}`
+		admin  = `{"status":"administrator","user":{"id":42,"is_bot":true,"first_name":"Bot"},"can_be_edited":false,"is_anonymous":false,"can_manage_chat":true,"can_delete_messages":true,"can_manage_video_chats":false,"can_restrict_members":false,"can_promote_members":false,"can_change_info":false,"can_invite_users":false,"can_post_stories":false,"can_edit_stories":false,"can_delete_stories":false}`
+		member = `{"status":"member","user":{"id":42,"is_bot":true,"first_name":"Bot"}}`
+		left   = `{"status":"left","user":{"id":42,"is_bot":true,"first_name":"Bot"}}`
+	)
+	tests := []struct {
+		name    string
+		member  string
+		right   string
+		want    bool
+		wantErr bool
+	}{
+		{name: "owner", member: owner, right: "can_restrict_members", want: true},
+		{name: "admin with the right", member: admin, right: "can_delete_messages", want: true},
+		{name: "admin without the right", member: admin, right: "can_restrict_members", want: false},
+		{name: "member", member: member, right: "can_delete_messages", want: false},
+		{name: "left", member: left, right: "can_delete_messages", want: false},
+		{name: "unknown right", member: admin, right: "can_fly", wantErr: true},
+		{name: "unknown right, not an admin", member: member, right: "can_fly", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := fakeClient(fakeBotMember(tt.member, &calls))
+			got, err := CanI(context.Background(), client, "42:abc", ChatID{ID: -1001234567890}, tt.right)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CanI() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CanI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRightsCache(t *testing.T) {
+	const admin = `{"status":"administrator","user":{"id":42,"is_bot":true,"first_name":"Bot"},"can_be_edited":false,"can_delete_messages":true}`
+	calls := 0
+	client := fakeClient(fakeBotMember(admin, &calls))
+	cache := NewRightsCache(0, func(ctx context.Context, chatID ChatID) (*ChatMember, error) {
+		return BotMember(ctx, client, "42:abc", chatID)
+	})
+	chat := ChatID{ID: -1001234567890}
+
+	for range 3 {
+		ok, err := cache.CanI(context.Background(), chat, "can_delete_messages")
+		if err != nil || !ok {
+			t.Fatalf("CanI() = %v, %v", ok, err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("%d calls for three checks, want 2 (getMe and getChatMember once)", calls)
+	}
+
+	cache.HandleUpdate(&Update{MyChatMember: &ChatMemberUpdated{Chat: Chat{ID: chat.ID}}})
+	if _, err := cache.CanI(context.Background(), chat, "can_delete_messages"); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 4 {
+		t.Errorf("%d calls after my_chat_member, want 4", calls)
+	}
+}
@@ -0,0 +1,277 @@
+/* roundtrip_test.go : JSON round-trip tests of the types
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// Samples of the JSON Telegram sends or receives, one per type with custom encoding or many fields.
+// Each one must survive unmarshal → marshal → unmarshal → marshal with the same output, and the
+// first marshal must still contain everything the sample has
+var roundTripSamples = []struct {
+	name string
+	new  func() any
+	json string
+}{
+	{"Update with message", func() any { return new(Update) }, `{
+		"update_id": 1001,
+		"message": {
+			"message_id": 5, "date": 1700000000,
+			"chat": {"id": -1001234567890, "type": "supergroup", "title": "Group", "username": "group"},
+			"from": {"id": 7, "is_bot": false, "first_name": "Ann", "username": "ann"},
+			"text": "/start hello", "entities": [{"type": "bot_command", "offset": 0, "length": 6}]
+		}
+	}`},
+	{"Update with callback query", func() any { return new(Update) }, `{
+		"update_id": 1002,
+		"callback_query": {
+			"id": "q1", "from": {"id": 7, "is_bot": false, "first_name": "Ann"},
+			"chat_instance": "ci", "data": "page:2",
+			"message": {"chat": {"id": 7, "type": "private"}, "message_id": 9, "date": 0}
+		}
+	}`},
+	{"MaybeInaccessibleMessage", func() any { return new(MaybeInaccessibleMessage) }, `{
+		"message_id": 3, "date": 1700000000, "chat": {"id": 1, "type": "private"}, "text": "hi"
+	}`},
+	{"MessageOrigin user", func() any { return new(MessageOrigin) }, `{
+		"type": "user", "date": 1700000000, "sender_user": {"id": 1, "is_bot": false, "first_name": "A"}
+	}`},
+	{"MessageOrigin hidden user", func() any { return new(MessageOrigin) }, `{
+		"type": "hidden_user", "date": 1700000000, "sender_user_name": "Someone"
+	}`},
+	{"MessageOrigin chat", func() any { return new(MessageOrigin) }, `{
+		"type": "chat", "date": 1700000000, "sender_chat": {"id": -100, "type": "supergroup"}, "author_signature": "admin"
+	}`},
+	{"MessageOrigin channel", func() any { return new(MessageOrigin) }, `{
+		"type": "channel", "date": 1700000000, "chat": {"id": -1009, "type": "channel"}, "message_id": 4
+	}`},
+	{"ChatMember owner", func() any { return new(ChatMember) }, `{
+		"status": "creator", "user": {"id": 1, "is_bot": false, "first_name": "A"}, "is_anonymous": false
+	}`},
+	{"ChatMember administrator", func() any { return new(ChatMember) }, `{
+		"status": "administrator", "user": {"id": 2, "is_bot": true, "first_name": "Bot"},
+		"can_be_edited": false, "is_anonymous": false, "can_manage_chat": true, "can_delete_messages": true,
+		"can_manage_video_chats": false, "can_restrict_members": true, "can_promote_members": false,
+		"can_change_info": false, "can_invite_users": true, "can_post_stories": false,
+		"can_edit_stories": false, "can_delete_stories": false
+	}`},
+	{"ChatMember member", func() any { return new(ChatMember) }, `{
+		"status": "member", "user": {"id": 3, "is_bot": false, "first_name": "C"}
+	}`},
+	{"ChatMember left", func() any { return new(ChatMember) }, `{
+		"status": "left", "user": {"id": 4, "is_bot": false, "first_name": "D"}
+	}`},
+	{"ChatMember banned", func() any { return new(ChatMember) }, `{
+		"status": "kicked", "user": {"id": 5, "is_bot": false, "first_name": "E"}, "until_date": 0
+	}`},
+	{"ReactionType emoji", func() any { return new(ReactionType) }, `{"type": "emoji", "emoji": "👍"}`},
+	{"ReactionType custom emoji", func() any { return new(ReactionType) }, `{"type": "custom_emoji", "custom_emoji_id": "123"}`},
+	{"ReactionType paid", func() any { return new(ReactionType) }, `{"type": "paid"}`},
+	{"ChatBoostSource premium", func() any { return new(ChatBoostSource) }, `{
+		"source": "premium", "user": {"id": 6, "is_bot": false, "first_name": "F"}
+	}`},
+	{"ChatBoostSource giveaway", func() any { return new(ChatBoostSource) }, `{
+		"source": "giveaway", "giveaway_message_id": 12, "is_unclaimed": true
+	}`},
+	{"Message with contact", func() any { return new(Message) }, `{
+		"message_id": 8, "date": 1700000000, "chat": {"id": 7, "type": "private"},
+		"contact": {
+			"phone_number": "+390612345678", "first_name": "Ann", "last_name": "Rossi", "user_id": 7,
+			"vcard": "BEGIN:VCARD\nVERSION:3.0\nFN:Ann Rossi\nTEL;TYPE=CELL:+390612345678\nEND:VCARD"
+		}
+	}`},
+	{"SendContactParams", func() any { return new(SendContactParams) }, `{
+		"chat_id": 7, "phone_number": "+390612345678", "first_name": "Ann", "last_name": "Rossi",
+		"vcard": "BEGIN:VCARD\nVERSION:3.0\nFN:Ann Rossi\nEND:VCARD"
+	}`},
+	{"Message with audio and thumbnail", func() any { return new(Message) }, `{
+		"message_id": 10, "date": 1700000000, "chat": {"id": 7, "type": "private"},
+		"audio": {
+			"file_id": "a1", "file_unique_id": "ua1", "duration": 215, "performer": "Band", "title": "Song",
+			"file_name": "song.mp3", "mime_type": "audio/mpeg", "file_size": 3456789,
+			"thumbnail": {"file_id": "t1", "file_unique_id": "ut1", "width": 320, "height": 320, "file_size": 12000}
+		},
+		"caption": "new single"
+	}`},
+	{"Message with voice", func() any { return new(Message) }, `{
+		"message_id": 11, "date": 1700000000, "chat": {"id": 7, "type": "private"},
+		"voice": {"file_id": "v1", "file_unique_id": "uv1", "duration": 4, "mime_type": "audio/ogg", "file_size": 9000}
+	}`},
+	{"Message via inline bot", func() any { return new(Message) }, `{
+		"message_id": 12, "date": 1700000000, "chat": {"id": -100, "type": "supergroup"},
+		"from": {"id": 7, "is_bot": false, "first_name": "Ann"},
+		"via_bot": {"id": 99, "is_bot": true, "first_name": "Finder", "username": "finderbot"},
+		"text": "Result 1"
+	}`},
+	{"Update with business message", func() any { return new(Update) }, `{
+		"update_id": 13,
+		"business_message": {
+			"message_id": 5, "date": 1700000000, "chat": {"id": 7, "type": "private", "first_name": "Ann"},
+			"from": {"id": 42, "is_bot": false, "first_name": "Shop"},
+			"sender_business_bot": {"id": 99, "is_bot": true, "first_name": "Assistant", "username": "assistantbot"},
+			"business_connection_id": "bc1", "text": "your order shipped"
+		}
+	}`},
+	{"SendAudioParams", func() any { return new(SendAudioParams) }, `{
+		"chat_id": 7, "audio": "attach://song", "caption": "new single", "duration": 215,
+		"performer": "Band", "title": "Song", "thumbnail": "attach://cover"
+	}`},
+	{"ChatID number", func() any { return new(ChatID) }, `-1001234567890`},
+	{"ChatID username", func() any { return new(ChatID) }, `"@channel"`},
+	{"InlineKeyboardMarkup", func() any { return new(InlineKeyboardMarkup) }, `{
+		"inline_keyboard": [[{"text": "Next", "callback_data": "page:1"}, {"text": "Site", "url": "https://example.com"}]]
+	}`},
+	{"SendMessageParams", func() any { return new(SendMessageParams) }, `{
+		"chat_id": "@channel", "text": "hello", "parse_mode": "HTML", "disable_notification": true,
+		"reply_parameters": {"message_id": 3, "quote": "he", "quote_position": 0}
+	}`},
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, tt := range roundTripSamples {
+		t.Run(tt.name, func(t *testing.T) {
+			first := tt.new()
+			if err := json.Unmarshal([]byte(tt.json), first); err != nil {
+				t.Fatalf("unmarshal sample: %v", err)
+			}
+			data1, err := json.Marshal(first)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			second := tt.new()
+			if err := json.Unmarshal(data1, second); err != nil {
+				t.Fatalf("unmarshal %s: %v", data1, err)
+			}
+			data2, err := json.Marshal(second)
+			if err != nil {
+				t.Fatalf("second marshal: %v", err)
+			}
+			if !bytes.Equal(data1, data2) {
+				t.Errorf("unstable encoding:\n%s\n%s", data1, data2)
+			}
+
+			var want, got any
+			json.Unmarshal([]byte(tt.json), &want)
+			json.Unmarshal(data1, &got)
+			if path, ok := containsJSON(want, got, "$"); !ok {
+				t.Errorf("%s lost or changed in %s", path, data1)
+			}
+		})
+	}
+}
+
+// Reports whether got has every field of want with the same value (got may have more fields).
+// On failure it also returns the path of the first difference
+func containsJSON(want, got any, path string) (string, bool) {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return path, false
+		}
+		for k, v := range w {
+			if p, ok := containsJSON(v, g[k], path+"."+k); !ok {
+				return p, false
+			}
+		}
+		return "", true
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			return path, false
+		}
+		for i := range w {
+			if p, ok := containsJSON(w[i], g[i], path+"[]"); !ok {
+				return p, false
+			}
+		}
+		return "", true
+	}
+	if got == nil && (want == false || want == 0.0 || want == "") { // zero values may be omitted
+		return "", true
+	}
+	return path, reflect.DeepEqual(want, got)
+}
+
+// FuzzUnionUnmarshal feeds arbitrary JSON to the decoders of the unions: they may fail, but never panic,
+// and whatever they accept must encode again
+func FuzzUnionUnmarshal(f *testing.F) {
+	for _, s := range roundTripSamples {
+		f.Add([]byte(s.json))
+	}
+	f.Add([]byte(`{"type": 1}`))
+	f.Add([]byte(`{"status": null}`))
+	f.Add([]byte(`{"date": "x"}`))
+	f.Add([]byte(`[]`))
+	f.Add([]byte(`null`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, v := range []any{
+			new(MessageOrigin), new(ChatMember), new(ReactionType), new(ChatBoostSource),
+			new(MaybeInaccessibleMessage), new(Update), new(ChatID),
+		} {
+			if json.Unmarshal(data, v) != nil {
+				continue
+			}
+			if _, err := json.Marshal(v); err != nil {
+				t.Errorf("%T decoded %q but doesn't encode: %v", v, data, err)
+			}
+		}
+	})
+}
+
+// Unions built by hand, without their discriminator: MarshalJSON must fill it in,
+// or the value wouldn't decode back to the same member
+var handBuiltUnions = []struct {
+	name  string
+	value any
+	new   func() any
+	want  string // the discriminator MarshalJSON must add
+}{
+	{"ChatMember owner", ChatMember{Owner: &ChatMemberOwner{User: User{ID: 1, FirstName: "A"}}}, func() any { return new(ChatMember) }, `"status":"creator"`},
+	{"ChatMember administrator", ChatMember{Administrator: &ChatMemberAdministrator{User: User{ID: 2, FirstName: "B"}, CustomTitle: "mod"}}, func() any { return new(ChatMember) }, `"status":"administrator"`},
+	{"ChatMember member", ChatMember{Member: &ChatMemberMember{User: User{ID: 3, FirstName: "C"}}}, func() any { return new(ChatMember) }, `"status":"member"`},
+	{"ChatMember restricted", ChatMember{Restricted: &ChatMemberRestricted{User: User{ID: 4, FirstName: "D"}, IsMember: true}}, func() any { return new(ChatMember) }, `"status":"restricted"`},
+	{"ChatMember left", ChatMember{Left: &ChatMemberLeft{User: User{ID: 5, FirstName: "E"}}}, func() any { return new(ChatMember) }, `"status":"left"`},
+	{"ChatMember banned", ChatMember{Banned: &ChatMemberBanned{User: User{ID: 6, FirstName: "F"}, UntilDate: 1700000000}}, func() any { return new(ChatMember) }, `"status":"kicked"`},
+	{"MessageOrigin user", MessageOrigin{User: &MessageOriginUser{Date: 1700000000, SenderUser: User{ID: 7, FirstName: "G"}}}, func() any { return new(MessageOrigin) }, `"type":"user"`},
+	{"MessageOrigin hidden user", MessageOrigin{HiddenUser: &MessageOriginHiddenUser{Date: 1700000000, SendUserName: "Someone"}}, func() any { return new(MessageOrigin) }, `"type":"hidden_user"`},
+	{"MessageOrigin chat", MessageOrigin{Chat: &MessageOriginChat{Date: 1700000000, SenderChat: Chat{ID: -100, Type: "supergroup"}, AuthorSignature: "admin"}}, func() any { return new(MessageOrigin) }, `"type":"chat"`},
+	{"MessageOrigin channel", MessageOrigin{Channel: &MessageOriginChannel{Date: 1700000000, Chat: Chat{ID: -1009, Type: "channel"}, MessageID: 4}}, func() any { return new(MessageOrigin) }, `"type":"channel"`},
+	{"ChatBoostSource premium", ChatBoostSource{Premium: &ChatBoostSourcePremium{User: User{ID: 8, FirstName: "H"}}}, func() any { return new(ChatBoostSource) }, `"source":"premium"`},
+	{"ChatBoostSource gift code", ChatBoostSource{GiftCode: &ChatBoostSourceGiftCode{User: User{ID: 9, FirstName: "I"}}}, func() any { return new(ChatBoostSource) }, `"source":"gift_code"`},
+	{"ChatBoostSource giveaway", ChatBoostSource{Giveaway: &ChatBoostSourceGiveaway{GiveawayMessageID: 11, IsUnclaimed: true}}, func() any { return new(ChatBoostSource) }, `"source":"giveaway"`},
+}
+
+func TestHandBuiltUnionRoundTrip(t *testing.T) {
+	for _, tt := range handBuiltUnions {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if !bytes.Contains(data, []byte(tt.want)) {
+				t.Errorf("%s has no %s", data, tt.want)
+			}
+			decoded := tt.new()
+			if err := json.Unmarshal(data, decoded); err != nil {
+				t.Fatalf("unmarshal %s: %v", data, err)
+			}
+			again, err := json.Marshal(reflect.ValueOf(decoded).Elem().Interface())
+			if err != nil {
+				t.Fatalf("second marshal: %v", err)
+			}
+			if !bytes.Equal(data, again) {
+				t.Errorf("the value changed in the round trip:\n%s\n%s", data, again)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/* split.go : splitting long texts into several messages
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Maximum length of the text of a message, in UTF-16 code units
+const MaxMessageTextLength = 4096
+
+// SplitMessage splits a text longer than MaxMessageTextLength into several messages.
+// It prefers to cut at a paragraph break, then at a line break, then at a space, and
+// it never cuts inside an entity unless the entity alone is longer than a message.
+// The separator the text is cut at is dropped, and so are the chunks made only of whitespace,
+// which Telegram refuses as empty messages. Entities are re-sliced and re-offset
+// for each chunk. A surrogate pair is never split.
+//
+// Only the Text and Entities fields of the returned params are set: the caller fills in
+// the chat and the rest. Text formatted with parse_mode can't be split this way, because
+// the markup isn't parsed yet; use entities instead
+func SplitMessage(text string, entities []MessageEntity) []SendMessageParams {
+	if text == "" {
+		return nil
+	}
+	units := utf16.Encode([]rune(text))
+
+	var chunks []SendMessageParams
+	start := 0
+	for start < len(units) {
+		cut, next := len(units), len(units)
+		if len(units)-start > MaxMessageTextLength {
+			cut, next = findCut(units, entities, start, start+MaxMessageTextLength)
+		}
+		if chunk := string(utf16.Decode(units[start:cut])); strings.TrimSpace(chunk) != "" {
+			chunks = append(chunks, SendMessageParams{
+				Text:     chunk,
+				Entities: sliceEntities(entities, start, cut),
+			})
+		}
+		start = next
+	}
+	return chunks
+}
+
+// Finds where to cut units[start:limit]. It returns the end of the chunk and the start
+// of the next one: they differ when the cut happens at a separator, which is dropped
+func findCut(units []uint16, entities []MessageEntity, start, limit int) (cut, next int) {
+	for _, sep := range []string{"\n\n", "\n", " "} {
+		sepUnits := utf16.Encode([]rune(sep))
+		for c := limit - len(sepUnits); c > start; c-- {
+			if hasPrefix(units[c:], sepUnits) && cutAllowed(entities, c, c+len(sepUnits)) {
+				return c, c + len(sepUnits)
+			}
+		}
+	}
+
+	// No separator: cut just before an entity, or between two of them
+	for c := limit; c > start; c-- {
+		if !isLowSurrogate(units[c]) && cutAllowed(entities, c, c) {
+			return c, c
+		}
+	}
+
+	// An entity is longer than a whole message, so it has to be broken
+	c := limit
+	if isLowSurrogate(units[c]) {
+		c--
+	}
+	return c, c
+}
+
+// Reports whether the text can be cut at c, dropping the units in [c, next).
+// The cut must not fall strictly inside an entity and no entity may lose units
+func cutAllowed(entities []MessageEntity, c, next int) bool {
+	for _, e := range entities {
+		off, end := int(e.Offset), int(e.Offset+e.Length)
+		if off < c && c < end {
+			return false
+		}
+		if off < next && end > c {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the entities that overlap [start, end), clipped to it and shifted to start at 0
+func sliceEntities(entities []MessageEntity, start, end int) []MessageEntity {
+	var out []MessageEntity
+	for _, e := range entities {
+		off, eEnd := int(e.Offset), int(e.Offset+e.Length)
+		if off < start {
+			off = start
+		}
+		if eEnd > end {
+			eEnd = end
+		}
+		if off >= eEnd {
+			continue
+		}
+		e.Offset = int64(off - start)
+		e.Length = int64(eEnd - off)
+		out = append(out, e)
+	}
+	return out
+}
+
+func hasPrefix(units, prefix []uint16) bool {
+	if len(units) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if units[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+/* split_test.go : tests for splitting long messages
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestSplitMessage(t *testing.T) {
+	emoji := "😀" // 2 UTF-16 units
+	tests := []struct {
+		name     string
+		text     string
+		entities []MessageEntity
+		chunks   int
+	}{
+		{"empty", "", nil, 0},
+		{"short", "hello", nil, 1},
+		{"exactly the limit", strings.Repeat("a", MaxMessageTextLength), nil, 1},
+		{"emoji across the limit", strings.Repeat("a", MaxMessageTextLength-1) + emoji, nil, 2},
+		{"emoji only", strings.Repeat(emoji, MaxMessageTextLength/2+1), nil, 2},
+		{"paragraphs", strings.Repeat("a", 3000) + "\n\n" + strings.Repeat("b", 3000), nil, 2},
+		{"whitespace run at the cut", strings.Repeat("a", 4000) + strings.Repeat(" ", 5000) + "b", nil, 2},
+		{"whitespace only", strings.Repeat(" ", 5000), nil, 0},
+		{
+			"entity not broken",
+			strings.Repeat("a", 4000) + " " + strings.Repeat(emoji, 100),
+			[]MessageEntity{{Type: "bold", Offset: 3990, Length: 211}},
+			2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := SplitMessage(tt.text, tt.entities)
+			if len(chunks) != tt.chunks {
+				t.Fatalf("got %d chunks, want %d", len(chunks), tt.chunks)
+			}
+			for i, c := range chunks {
+				if n := UTF16Length(c.Text); n > MaxMessageTextLength {
+					t.Errorf("chunk %d is %d units long", i, n)
+				}
+				if strings.TrimSpace(c.Text) == "" {
+					t.Errorf("chunk %d is only whitespace", i)
+				}
+				if !utf16Valid(c.Text) {
+					t.Errorf("chunk %d has a broken surrogate pair", i)
+				}
+				for _, e := range c.Entities {
+					if e.Offset < 0 || e.Offset+e.Length > UTF16Length(c.Text) {
+						t.Errorf("chunk %d: entity %+v out of range", i, e)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSplitMessageKeepsEntities(t *testing.T) {
+	text := strings.Repeat("a", 4090) + " 😀bold😀 tail"
+	start := int64(4091)
+	entities := []MessageEntity{{Type: "bold", Offset: start, Length: UTF16Length("😀bold😀")}}
+
+	chunks := SplitMessage(text, entities)
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks, want 2", len(chunks))
+	}
+	if len(chunks[0].Entities) != 0 {
+		t.Errorf("first chunk has entities %+v", chunks[0].Entities)
+	}
+	if len(chunks[1].Entities) != 1 {
+		t.Fatalf("second chunk has entities %+v", chunks[1].Entities)
+	}
+	if got := EntityText(chunks[1].Text, chunks[1].Entities[0]); got != "😀bold😀" {
+		t.Errorf("entity covers %q", got)
+	}
+}
+
+// Reports whether s survives a round trip through UTF-16, i.e. no surrogate was cut in half
+func utf16Valid(s string) bool {
+	return string(utf16.Decode(utf16.Encode([]rune(s)))) == s && !strings.ContainsRune(s, '�')
+}
@@ -0,0 +1,252 @@
+/* stickers.go : sticker helpers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Maximum number of custom emoji identifiers getCustomEmojiStickers accepts in one call
+const MaxCustomEmojiStickers = 200
+
+// Limits on the emoji and keywords of an InputSticker
+const (
+	MaxStickerEmoji          = 20
+	MaxStickerKeywords       = 20
+	MaxStickerKeywordsLength = 64
+)
+
+// CustomEmojiIDs returns the identifiers of the "custom_emoji" entities, without duplicates
+// and in order of appearance. They are what getCustomEmojiStickers wants to get the Sticker
+// behind each custom emoji
+func CustomEmojiIDs(entities []MessageEntity) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, e := range entities {
+		if e.Type != "custom_emoji" || e.CustomEmojiID == "" || seen[e.CustomEmojiID] {
+			continue
+		}
+		seen[e.CustomEmojiID] = true
+		ids = append(ids, e.CustomEmojiID)
+	}
+	return ids
+}
+
+// Validate checks the parameters of getCustomEmojiStickers
+func (p *GetCustomEmojiStickersParams) Validate() error {
+	if n := len(p.CustomEmojiIDs); n < 1 || n > MaxCustomEmojiStickers {
+		return fmt.Errorf("telegram: %d custom emoji identifiers, it must be 1-%d", n, MaxCustomEmojiStickers)
+	}
+	return nil
+}
+
+// GetCustomEmojiStickers calls getCustomEmojiStickers. CustomEmojiIDs collects the identifiers of a text.
+// Identifiers that don't exist are skipped, so the result may be shorter than ids
+func GetCustomEmojiStickers(ctx context.Context, client *http.Client, token string, ids []string) ([]Sticker, error) {
+	params := GetCustomEmojiStickersParams{CustomEmojiIDs: ids}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	return Call[[]Sticker](ctx, client, token, "getCustomEmojiStickers", &params)
+}
+
+// ResolveCustomEmoji returns the sticker behind a "custom_emoji" entity.
+// To resolve all the custom emoji of a text at once, use CustomEmojiIDs and GetCustomEmojiStickers
+func (e *MessageEntity) ResolveCustomEmoji(ctx context.Context, client *http.Client, token string) (*Sticker, error) {
+	if e.Type != "custom_emoji" || e.CustomEmojiID == "" {
+		return nil, fmt.Errorf("telegram: entity of type %q is not a custom emoji", e.Type)
+	}
+	stickers, err := GetCustomEmojiStickers(ctx, client, token, []string{e.CustomEmojiID})
+	if err != nil {
+		return nil, err
+	}
+	if len(stickers) == 0 {
+		return nil, fmt.Errorf("telegram: custom emoji %s not found", e.CustomEmojiID)
+	}
+	return &stickers[0], nil
+}
+
+// Validate checks the sticker against the rules documented for InputSticker
+func (s *InputSticker) Validate() error {
+	if s.Sticker == "" {
+		return fmt.Errorf("telegram: input sticker has no file")
+	}
+	switch s.Format {
+	case "static", "animated", "video":
+	default:
+		return fmt.Errorf("telegram: unknown sticker format %q", s.Format)
+	}
+	if n := len(s.EmojiList); n < 1 || n > MaxStickerEmoji {
+		return fmt.Errorf("telegram: a sticker must have 1-%d emoji, got %d", MaxStickerEmoji, n)
+	}
+	if err := validateStickerKeywords(s.Keywords); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of replaceStickerInSet.
+// Whether OldSticker really belongs to the set can only be checked by Telegram
+func (p *ReplaceStickerInSetParams) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("telegram: sticker set name is empty")
+	}
+	if p.OldSticker == "" {
+		return fmt.Errorf("telegram: the file_id of the sticker to replace is empty")
+	}
+	return p.Sticker.Validate()
+}
+
+// Validate checks the parameters of deleteStickerSet
+func (p *DeleteStickerSetParams) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("telegram: sticker set name is empty")
+	}
+	return nil
+}
+
+// Keywords are limited in number and in total length (not per keyword)
+func validateStickerKeywords(keywords []string) error {
+	if len(keywords) > MaxStickerKeywords {
+		return fmt.Errorf("a sticker can have at most %d keywords, got %d", MaxStickerKeywords, len(keywords))
+	}
+	var total int64
+	for _, k := range keywords {
+		total += UTF16Length(k)
+	}
+	if total > MaxStickerKeywordsLength {
+		return fmt.Errorf("sticker keywords are %d characters in total, the limit is %d", total, MaxStickerKeywordsLength)
+	}
+	return nil
+}
+
+// Validate checks the parameters of setStickerKeywords
+func (p *SetStickerKeywordsParams) Validate() error {
+	if p.Sticker == "" {
+		return fmt.Errorf("telegram: the file_id of the sticker is empty")
+	}
+	if err := validateStickerKeywords(p.Keywords); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the parameters of setCustomEmojiStickerSetThumbnail
+func (p *SetCustomEmojiStickerSetThumbnailParams) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("telegram: sticker set name is empty")
+	}
+	return nil
+}
+
+// Validate checks the parameters of setChatStickerSet. Even for a supergroup, Telegram refuses the
+// call if the group hasn't got enough members or boosts: see ChatFullInfo.CanSetStickerSet
+func (p *SetChatStickerSetParams) Validate() error {
+	if err := validateSupergroup(p.ChatID); err != nil {
+		return fmt.Errorf("telegram: chat sticker set: %w", err)
+	}
+	if p.StickerSetName == "" {
+		return fmt.Errorf("telegram: sticker set name is empty")
+	}
+	return nil
+}
+
+// Validate checks the parameters of deleteChatStickerSet
+func (p *DeleteChatStickerSetParams) Validate() error {
+	if err := validateSupergroup(p.ChatID); err != nil {
+		return fmt.Errorf("telegram: chat sticker set: %w", err)
+	}
+	return nil
+}
+
+// SetChatStickerSet calls setChatStickerSet. Telegram refuses it for the groups without enough members
+// or boosts: its error, an *APIError, is wrapped to say so. ChatFullInfo.CanSetStickerSet tells beforehand
+func SetChatStickerSet(ctx context.Context, client *http.Client, token string, chatID ChatID, stickerSetName string) error {
+	params := SetChatStickerSetParams{ChatID: chatID, StickerSetName: stickerSetName}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setChatStickerSet", &params)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode == 400 && !IsChatNotFound(err) {
+		return fmt.Errorf("telegram: can't set the sticker set of %s, see ChatFullInfo.CanSetStickerSet: %w", chatID, err)
+	}
+	return err
+}
+
+// DeleteChatStickerSet calls deleteChatStickerSet, with the same rules as SetChatStickerSet
+func DeleteChatStickerSet(ctx context.Context, client *http.Client, token string, chatID ChatID) error {
+	params := DeleteChatStickerSetParams{ChatID: chatID}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "deleteChatStickerSet", &params)
+	return err
+}
+
+// SetStickerKeywords calls setStickerKeywords. No keywords remove the ones the sticker has
+func SetStickerKeywords(ctx context.Context, client *http.Client, token, sticker string, keywords []string) error {
+	params := SetStickerKeywordsParams{Sticker: sticker, Keywords: keywords}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setStickerKeywords", &params)
+	return err
+}
+
+// SetCustomEmojiStickerSetThumbnail calls setCustomEmojiStickerSetThumbnail. An empty customEmojiID
+// removes the thumbnail, and the first sticker of the set is used instead
+func SetCustomEmojiStickerSetThumbnail(ctx context.Context, client *http.Client, token, name, customEmojiID string) error {
+	params := SetCustomEmojiStickerSetThumbnailParams{Name: name, CustomEmojiID: customEmojiID}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "setCustomEmojiStickerSetThumbnail", &params)
+	return err
+}
+
+// DeleteStickerSet calls deleteStickerSet, for a sticker set created by the bot
+func DeleteStickerSet(ctx context.Context, client *http.Client, token, name string) error {
+	params := DeleteStickerSetParams{Name: name}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	_, err := callBool(ctx, client, token, "deleteStickerSet", &params)
+	return err
+}
+
+// ReplaceStickerInSet calls replaceStickerInSet. If params.Sticker.Sticker is "attach://<name>",
+// files[<name>] is uploaded with multipart/form-data; for a file_id or a URL files can be nil.
+// Telegram's refusal, e.g. because OldSticker isn't in the set, is wrapped to name the sticker and the set
+func ReplaceStickerInSet(ctx context.Context, client *http.Client, token string, params ReplaceStickerInSetParams, files map[string]*InputFile) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	var err error
+	if attach, ok := strings.CutPrefix(params.Sticker.Sticker, "attach://"); ok {
+		if files[attach] == nil {
+			return fmt.Errorf("telegram: no file to upload as %s", params.Sticker.Sticker)
+		}
+		var data []byte
+		if data, err = postMultipart(ctx, client, token, "replaceStickerInSet", &params, files); err == nil {
+			_, err = DecodeBoolResponse(data)
+		}
+	} else {
+		_, err = callBool(ctx, client, token, "replaceStickerInSet", &params)
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode == 400 {
+		return fmt.Errorf("telegram: can't replace sticker %s in set %s: %w", params.OldSticker, params.Name, err)
+	}
+	return err
+}
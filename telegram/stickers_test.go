@@ -0,0 +1,261 @@
+/* stickers_test.go : tests for the sticker helpers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestStickerSetMethods(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(client *http.Client) error
+		wantMethod string
+		wantParams string
+		wantErr    bool
+	}{
+		{
+			name: "set keywords",
+			call: func(client *http.Client) error {
+				return SetStickerKeywords(context.Background(), client, "123:abc", "sticker-1", []string{"cat", "happy"})
+			},
+			wantMethod: "setStickerKeywords",
+			wantParams: `{"sticker":"sticker-1","keywords":["cat","happy"]}`,
+		},
+		{
+			name: "remove keywords",
+			call: func(client *http.Client) error {
+				return SetStickerKeywords(context.Background(), client, "123:abc", "sticker-1", nil)
+			},
+			wantMethod: "setStickerKeywords",
+			wantParams: `{"sticker":"sticker-1"}`,
+		},
+		{
+			name: "too many keywords",
+			call: func(client *http.Client) error {
+				return SetStickerKeywords(context.Background(), client, "123:abc", "sticker-1", strings.Fields(strings.Repeat("k ", MaxStickerKeywords+1)))
+			},
+			wantErr: true,
+		},
+		{
+			name: "keywords too long",
+			call: func(client *http.Client) error {
+				return SetStickerKeywords(context.Background(), client, "123:abc", "sticker-1", []string{strings.Repeat("a", 40), strings.Repeat("b", 25)})
+			},
+			wantErr: true,
+		},
+		{
+			name: "set thumbnail",
+			call: func(client *http.Client) error {
+				return SetCustomEmojiStickerSetThumbnail(context.Background(), client, "123:abc", "pack_by_bot", "5368324170671202286")
+			},
+			wantMethod: "setCustomEmojiStickerSetThumbnail",
+			wantParams: `{"name":"pack_by_bot","custom_emoji_id":"5368324170671202286"}`,
+		},
+		{
+			name: "drop thumbnail",
+			call: func(client *http.Client) error {
+				return SetCustomEmojiStickerSetThumbnail(context.Background(), client, "123:abc", "pack_by_bot", "")
+			},
+			wantMethod: "setCustomEmojiStickerSetThumbnail",
+			wantParams: `{"name":"pack_by_bot"}`,
+		},
+		{
+			name: "delete set",
+			call: func(client *http.Client) error {
+				return DeleteStickerSet(context.Background(), client, "123:abc", "pack_by_bot")
+			},
+			wantMethod: "deleteStickerSet",
+			wantParams: `{"name":"pack_by_bot"}`,
+		},
+		{
+			name: "delete set without name",
+			call: func(client *http.Client) error {
+				return DeleteStickerSet(context.Background(), client, "123:abc", "")
+			},
+			wantErr: true,
+		},
+		{
+			name: "replace by file_id",
+			call: func(client *http.Client) error {
+				return ReplaceStickerInSet(context.Background(), client, "123:abc", ReplaceStickerInSetParams{
+					UserID: 42, Name: "pack_by_bot", OldSticker: "old-1",
+					Sticker: InputSticker{Sticker: "new-1", Format: "static", EmojiList: []string{"😀"}},
+				}, nil)
+			},
+			wantMethod: "replaceStickerInSet",
+			wantParams: `{"user_id":42,"name":"pack_by_bot","old_sticker":"old-1","sticker":{"sticker":"new-1","format":"static","emoji_list":["😀"]}}`,
+		},
+		{
+			name: "replace with a missing upload",
+			call: func(client *http.Client) error {
+				return ReplaceStickerInSet(context.Background(), client, "123:abc", ReplaceStickerInSetParams{
+					UserID: 42, Name: "pack_by_bot", OldSticker: "old-1",
+					Sticker: InputSticker{Sticker: "attach://new", Format: "static", EmojiList: []string{"😀"}},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "thumbnail without set",
+			call: func(client *http.Client) error {
+				return SetCustomEmojiStickerSetThumbnail(context.Background(), client, "123:abc", "", "5368324170671202286")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod, gotParams string
+			client := fakeClient(func(method string, params []byte) string {
+				gotMethod, gotParams = method, string(params)
+				return `{"ok":true,"result":true}`
+			})
+			err := tt.call(client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotMethod != tt.wantMethod || gotParams != tt.wantParams {
+				t.Errorf("called %q with %s, want %q with %s", gotMethod, gotParams, tt.wantMethod, tt.wantParams)
+			}
+		})
+	}
+}
+
+func TestChatStickerSet(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(client *http.Client) error
+		answer     string
+		wantMethod string
+		wantErr    string // "" if no error
+		wantCalled bool
+	}{
+		{
+			name: "set",
+			call: func(client *http.Client) error {
+				return SetChatStickerSet(context.Background(), client, "123:abc", NewChatID(-1001234567890), "pack_by_bot")
+			},
+			answer:     `{"ok":true,"result":true}`,
+			wantMethod: "setChatStickerSet",
+			wantCalled: true,
+		},
+		{
+			name: "not enough boosts",
+			call: func(client *http.Client) error {
+				return SetChatStickerSet(context.Background(), client, "123:abc", NewChatID(-1001234567890), "pack_by_bot")
+			},
+			answer:     `{"ok":false,"error_code":400,"description":"Bad Request: can't set supergroup sticker set"}`,
+			wantMethod: "setChatStickerSet",
+			wantErr:    "CanSetStickerSet",
+			wantCalled: true,
+		},
+		{
+			name: "basic group",
+			call: func(client *http.Client) error {
+				return SetChatStickerSet(context.Background(), client, "123:abc", NewChatID(-12345), "pack_by_bot")
+			},
+			wantErr: "not a supergroup",
+		},
+		{
+			name: "no set name",
+			call: func(client *http.Client) error {
+				return SetChatStickerSet(context.Background(), client, "123:abc", NewChatUsername("group"), "")
+			},
+			wantErr: "sticker set name",
+		},
+		{
+			name: "delete",
+			call: func(client *http.Client) error {
+				return DeleteChatStickerSet(context.Background(), client, "123:abc", NewChatUsername("group"))
+			},
+			answer:     `{"ok":true,"result":true}`,
+			wantMethod: "deleteChatStickerSet",
+			wantCalled: true,
+		},
+		{
+			name: "delete in a private chat",
+			call: func(client *http.Client) error {
+				return DeleteChatStickerSet(context.Background(), client, "123:abc", NewChatID(42))
+			},
+			wantErr: "not a supergroup",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod string
+			client := fakeClient(func(method string, params []byte) string {
+				gotMethod = method
+				return tt.answer
+			})
+			err := tt.call(client)
+			if tt.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+			}
+			if called := gotMethod != ""; called != tt.wantCalled || gotMethod != tt.wantMethod {
+				t.Errorf("called %q, want %q", gotMethod, tt.wantMethod)
+			}
+		})
+	}
+}
+
+func TestReplaceStickerInSet(t *testing.T) {
+	params := ReplaceStickerInSetParams{
+		UserID: 42, Name: "pack_by_bot", OldSticker: "old-1",
+		Sticker: InputSticker{Sticker: "attach://new", Format: "static", EmojiList: []string{"😀"}},
+	}
+
+	t.Run("upload", func(t *testing.T) {
+		var form *multipart.Form
+		client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				return nil, err
+			}
+			form = req.MultipartForm
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"ok":true,"result":true}`)),
+				Request:    req,
+			}, nil
+		})}
+		files := map[string]*InputFile{"new": {Name: "new.webp", Reader: strings.NewReader("RIFF")}}
+		if err := ReplaceStickerInSet(context.Background(), client, "123:abc", params, files); err != nil {
+			t.Fatal(err)
+		}
+		if got := form.Value["sticker"]; len(got) != 1 || got[0] != `{"sticker":"attach://new","format":"static","emoji_list":["😀"]}` {
+			t.Errorf("sticker field = %q", got)
+		}
+		if got := form.Value["old_sticker"]; len(got) != 1 || got[0] != "old-1" {
+			t.Errorf("old_sticker field = %q", got)
+		}
+		if got := form.File["new"]; len(got) != 1 || got[0].Filename != "new.webp" {
+			t.Errorf("uploaded files = %v", form.File)
+		}
+	})
+
+	t.Run("sticker not in the set", func(t *testing.T) {
+		client := fakeClient(func(method string, params []byte) string {
+			return `{"ok":false,"error_code":400,"description":"Bad Request: STICKER_ID_INVALID"}`
+		})
+		p := params
+		p.Sticker.Sticker = "new-1"
+		err := ReplaceStickerInSet(context.Background(), client, "123:abc", p, nil)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || !strings.Contains(err.Error(), "old-1") || !strings.Contains(err.Error(), "pack_by_bot") {
+			t.Errorf("error = %v, want the APIError wrapped with the sticker and the set", err)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+/* store.go : a key-value store for the state of a bot
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Bots keep small pieces of state per user or per chat: the step of a
+ * conversation, the updates already seen, a setting. Store is the interface
+ * for them, so that the in-memory implementation here can be swapped for a
+ * persistent one (Redis, Bolt, a SQL table) without touching the handlers.
+ */
+
+package telegram
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Store is a key-value store with expiry. Implementations must be safe for concurrent use
+type Store interface {
+	// Get returns the value of key. The boolean is false if there is no such key, or it expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set sets the value of key, replacing the old one. The key expires after ttl, or never if ttl is 0.
+	// A negative ttl has already expired: the key is removed
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes key. Removing a key that doesn't exist is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// StateKey returns the key of the state of a user in a chat, "<name>:<chatID>:<userID>".
+// Pass 0 as userID for the state of the whole chat, or as chatID for the state of the user everywhere
+func StateKey(name string, chatID, userID int64) string {
+	return name + ":" + strconv.FormatInt(chatID, 10) + ":" + strconv.FormatInt(userID, 10)
+}
+
+// How often MemoryStore removes the expired keys nobody reads anymore
+const memoryStoreSweepInterval = time.Minute
+
+// MemoryStore is the Store that keeps everything in memory: the state is lost when the bot stops.
+// Expired keys are removed when read, and every minute or so while setting other keys.
+// The zero value is an empty MemoryStore ready to use
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	lastSweep time.Time
+}
+
+type memoryEntry struct {
+	value   []byte
+	expires time.Time // zero: never
+}
+
+func (e memoryEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]memoryEntry), lastSweep: time.Now()}
+}
+
+// Get implements Store. The value returned is a copy
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if e.expired(time.Now()) {
+		delete(s.entries, key)
+		return nil, false, nil
+	}
+	return append([]byte(nil), e.value...), true, nil
+}
+
+// Set implements Store. The value is copied, so the caller can reuse it
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	now := time.Now()
+	e := memoryEntry{value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		e.expires = now.Add(ttl)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ttl < 0 {
+		delete(s.entries, key)
+		return nil
+	}
+	if s.entries == nil {
+		s.entries = make(map[string]memoryEntry)
+	}
+	s.entries[key] = e
+	if now.Sub(s.lastSweep) >= memoryStoreSweepInterval {
+		for k, e := range s.entries {
+			if e.expired(now) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	return nil
+}
+
+// Delete implements Store
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// Len returns the number of keys stored, the expired ones not removed yet included
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
@@ -0,0 +1,159 @@
+/* store_test.go : tests for the in-memory store
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	if _, ok, err := s.Get(ctx, "missing"); ok || err != nil {
+		t.Errorf("Get(missing) = %v, %v, want false, nil", ok, err)
+	}
+
+	value := []byte("step1")
+	if err := s.Set(ctx, "k", value, 0); err != nil {
+		t.Fatal(err)
+	}
+	value[0] = 'X' // the store keeps its own copy
+	got, ok, err := s.Get(ctx, "k")
+	if !ok || err != nil || string(got) != "step1" {
+		t.Fatalf("Get(k) = %q, %v, %v, want step1", got, ok, err)
+	}
+	got[0] = 'Y'
+	if again, _, _ := s.Get(ctx, "k"); string(again) != "step1" {
+		t.Errorf("Get returned the stored slice, it was changed to %q", again)
+	}
+
+	if err := s.Set(ctx, "k", []byte("step2"), 0); err != nil {
+		t.Fatal(err)
+	}
+	if got, _, _ := s.Get(ctx, "k"); string(got) != "step2" {
+		t.Errorf("Get(k) after the second Set = %q, want step2", got)
+	}
+
+	if err := s.Delete(ctx, "k"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := s.Get(ctx, "k"); ok {
+		t.Error("the key is still there after Delete")
+	}
+	if err := s.Delete(ctx, "k"); err != nil {
+		t.Errorf("Delete of a missing key: %v", err)
+	}
+}
+
+func TestMemoryStoreTTL(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	if err := s.Set(ctx, "short", []byte("v"), 20*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set(ctx, "forever", []byte("v"), 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := s.Get(ctx, "short"); !ok {
+		t.Fatal("the key expired too early")
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if _, ok, _ := s.Get(ctx, "short"); ok {
+		t.Error("the key didn't expire")
+	}
+	if _, ok, _ := s.Get(ctx, "forever"); !ok {
+		t.Error("the key without ttl expired")
+	}
+	if n := s.Len(); n != 1 {
+		t.Errorf("Len() = %d after reading the expired key, want 1", n)
+	}
+}
+
+func TestMemoryStoreNegativeTTL(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	if err := s.Set(ctx, "k", []byte("v"), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set(ctx, "k", []byte("w"), -time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := s.Get(ctx, "k"); ok {
+		t.Error("a key set with a negative ttl is still there")
+	}
+	if n := s.Len(); n != 0 {
+		t.Errorf("Len() = %d, want 0", n)
+	}
+}
+
+func TestMemoryStoreZeroValue(t *testing.T) {
+	ctx := context.Background()
+	var s MemoryStore
+	if _, ok, err := s.Get(ctx, "k"); ok || err != nil {
+		t.Errorf("Get on the zero value = %v, %v", ok, err)
+	}
+	if err := s.Delete(ctx, "k"); err != nil {
+		t.Errorf("Delete on the zero value: %v", err)
+	}
+	if err := s.Set(ctx, "k", []byte("v"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok, _ := s.Get(ctx, "k"); !ok || string(got) != "v" {
+		t.Errorf("Get(k) = %q, %v, want v", got, ok)
+	}
+}
+
+// Run with -race
+func TestMemoryStoreConcurrent(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				key := StateKey("conv", int64(g), int64(i%10))
+				if err := s.Set(ctx, key, []byte(strconv.Itoa(i)), time.Minute); err != nil {
+					t.Error(err)
+					return
+				}
+				if _, _, err := s.Get(ctx, key); err != nil {
+					t.Error(err)
+					return
+				}
+				if i%3 == 0 {
+					if err := s.Delete(ctx, key); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+				s.Len()
+			}
+		}()
+	}
+	wg.Wait()
+	if n := s.Len(); n > 8*10 {
+		t.Errorf("Len() = %d, more than the %d keys used", n, 8*10)
+	}
+}
+
+func TestStateKey(t *testing.T) {
+	if got := StateKey("conv", -1001234567890, 42); got != "conv:-1001234567890:42" {
+		t.Errorf("StateKey() = %q", got)
+	}
+	if got := StateKey("lang", 0, 42); got != "lang:0:42" {
+		t.Errorf("StateKey() = %q", got)
+	}
+}
@@ -0,0 +1,151 @@
+/* throttle.go : coalescing edits of live-updating messages
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * A bot that shows progress ("downloading... 42%") edits the same message
+ * over and over, and Telegram answers with 429 Too Many Requests if it does
+ * that too often. Most intermediate states are useless anyway: what matters
+ * is that the user sees a recent one, and the final one for sure.
+ */
+
+package telegram
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// The function that actually edits a message, usually a call to editMessageText
+type EditFunc func(ctx context.Context, params EditMessageTextParams) error
+
+// EditThrottler sends at most one edit per message per interval. While an edit is
+// waiting, newer ones replace it, so only the latest text is sent. The last submitted
+// edit is always sent eventually. The "message is not modified" error is dropped, other
+// errors go to OnError. It is safe for concurrent use
+type EditThrottler struct {
+	interval time.Duration
+	edit     EditFunc
+
+	// [Optional] Called with the errors of the edits, which run in the background
+	OnError func(params EditMessageTextParams, err error)
+
+	mu       sync.Mutex
+	messages map[MessageLocator]*throttledMessage
+	inFlight int        // edits scheduled with a timer or being sent
+	idle     *sync.Cond // signalled when inFlight drops to 0
+}
+
+type throttledMessage struct {
+	lastEdit time.Time
+	pending  *EditMessageTextParams
+	ctx      context.Context
+	timer    *time.Timer
+}
+
+// NewEditThrottler returns an EditThrottler that calls edit at most once per interval for each message
+func NewEditThrottler(interval time.Duration, edit EditFunc) *EditThrottler {
+	t := &EditThrottler{
+		interval: interval,
+		edit:     edit,
+		messages: make(map[MessageLocator]*throttledMessage),
+	}
+	t.idle = sync.NewCond(&t.mu)
+	return t
+}
+
+// Submit schedules an edit. It is sent right away if the message wasn't edited in the
+// last interval, otherwise when the interval is over (unless a newer edit replaces it).
+// The context of the latest Submit is the one the edit runs with
+func (t *EditThrottler) Submit(ctx context.Context, params EditMessageTextParams) {
+	key := params.MessageLocator
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	m := t.messages[key]
+	if m == nil {
+		m = &throttledMessage{}
+		t.messages[key] = m
+	}
+	m.pending = &params
+	m.ctx = ctx
+	if m.timer != nil {
+		return // already scheduled, it will pick up the new params
+	}
+
+	wait := time.Until(m.lastEdit.Add(t.interval))
+	if wait < 0 {
+		wait = 0
+	}
+	// Each timer ends with exactly one flush: its own, or the one of Flush if Flush stops it
+	t.inFlight++
+	m.timer = time.AfterFunc(wait, func() { t.flush(key) })
+}
+
+// Flush sends all the pending edits now, without waiting for their interval, and returns
+// when they are done, along with the edits that were already being sent.
+// Call it before shutting down so the final states are not lost: an edit submitted
+// while Flush runs is waited for too, and may be sent only after its interval
+func (t *EditThrottler) Flush() {
+	t.mu.Lock()
+	var keys []MessageLocator
+	for key, m := range t.messages {
+		if m.timer != nil && m.timer.Stop() {
+			keys = append(keys, key)
+		}
+	}
+	t.mu.Unlock()
+
+	for _, key := range keys {
+		t.flush(key)
+	}
+
+	t.mu.Lock()
+	for t.inFlight > 0 {
+		t.idle.Wait()
+	}
+	t.mu.Unlock()
+}
+
+// Sends the pending edit of a message
+func (t *EditThrottler) flush(key MessageLocator) {
+	t.mu.Lock()
+	m := t.messages[key]
+	if m == nil || m.pending == nil {
+		t.done()
+		t.mu.Unlock()
+		return
+	}
+	params, ctx := *m.pending, m.ctx
+	m.pending, m.ctx, m.timer = nil, nil, nil
+	m.lastEdit = time.Now()
+	t.mu.Unlock()
+
+	err := t.edit(ctx, params)
+	if err != nil && !IsMessageNotModified(err) && t.OnError != nil {
+		t.OnError(params, err)
+	}
+
+	t.mu.Lock()
+	t.done()
+	t.mu.Unlock()
+
+	// Forget the message once its interval is over and nothing new was submitted,
+	// so that the map doesn't grow forever
+	time.AfterFunc(t.interval, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if m := t.messages[key]; m != nil && m.pending == nil && time.Since(m.lastEdit) >= t.interval {
+			delete(t.messages, key)
+		}
+	})
+}
+
+// Counts the end of a scheduled edit. Must be called with the lock held
+func (t *EditThrottler) done() {
+	if t.inFlight--; t.inFlight == 0 {
+		t.idle.Broadcast()
+	}
+}
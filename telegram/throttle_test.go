@@ -0,0 +1,120 @@
+/* throttle_test.go : tests for the edit throttler
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// An EditFunc that records the texts it sends
+type recordedEdits struct {
+	mu    sync.Mutex
+	texts []string
+}
+
+func (r *recordedEdits) edit(ctx context.Context, params EditMessageTextParams) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.texts = append(r.texts, params.Text)
+	return nil
+}
+
+func (r *recordedEdits) sent() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.texts)
+}
+
+func TestEditThrottlerCoalesces(t *testing.T) {
+	var rec recordedEdits
+	throttler := NewEditThrottler(time.Hour, rec.edit)
+	msg := ByChat(NewChatID(1), 10)
+
+	throttler.Submit(context.Background(), EditMessageTextParams{MessageLocator: msg, Text: "0%"})
+	throttler.Flush() // the first edit is sent at once, Flush waits for it
+	for _, text := range []string{"10%", "20%", "100%"} {
+		throttler.Submit(context.Background(), EditMessageTextParams{MessageLocator: msg, Text: text})
+	}
+	throttler.Flush()
+
+	if got, want := rec.sent(), []string{"0%", "100%"}; !slices.Equal(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestEditThrottlerErrors(t *testing.T) {
+	notModified := &APIError{ErrorCode: 400, Description: "Bad Request: message is not modified: specified new message content and reply markup are exactly the same as a current content and reply markup of the message"}
+	failure := &APIError{ErrorCode: 400, Description: "Bad Request: message to edit not found"}
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{name: "sent", err: nil},
+		{name: "not modified", err: notModified},
+		{name: "other error", err: failure, wantErr: failure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttler := NewEditThrottler(time.Hour, func(ctx context.Context, params EditMessageTextParams) error {
+				return tt.err
+			})
+			var got error
+			throttler.OnError = func(params EditMessageTextParams, err error) { got = err }
+			throttler.Submit(context.Background(), EditMessageTextParams{MessageLocator: ByChat(NewChatID(1), 10), Text: "done"})
+			throttler.Flush()
+			if !errors.Is(got, tt.wantErr) {
+				t.Errorf("OnError got %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEditThrottlerFlushWaitsForInFlightEdit(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var rec recordedEdits
+	throttler := NewEditThrottler(time.Hour, func(ctx context.Context, params EditMessageTextParams) error {
+		if params.Text == "first" {
+			close(started)
+			<-release
+		}
+		return rec.edit(ctx, params)
+	})
+	msg := ByChat(NewChatID(1), 10)
+
+	throttler.Submit(context.Background(), EditMessageTextParams{MessageLocator: msg, Text: "first"})
+	<-started
+	// Pending behind the first one, for the rest of the hour
+	throttler.Submit(context.Background(), EditMessageTextParams{MessageLocator: msg, Text: "last"})
+
+	flushed := make(chan struct{})
+	go func() {
+		throttler.Flush()
+		close(flushed)
+	}()
+	select {
+	case <-flushed:
+		t.Fatal("Flush returned while the first edit was still being sent")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-flushed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Flush didn't return after the edit was done")
+	}
+	if got, want := rec.sent(), []string{"first", "last"}; !slices.Equal(slices.Sorted(slices.Values(got)), want) {
+		t.Errorf("sent %q, want both %q", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+/* transport.go : headers of the outgoing requests
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Call and SaveFile take an *http.Client, so everything about the requests
+ * is configured there. To add headers to all of them (a custom User-Agent,
+ * tracing headers for an API gateway) wrap the client with WithHeaders:
+ *
+ *	client := telegram.WithHeaders(nil, http.Header{
+ *		"User-Agent":       {"mybot/1.2"},
+ *		"X-Request-Source": {"bots"},
+ *	})
+ */
+
+package telegram
+
+import (
+	"net/http"
+	"runtime/debug"
+)
+
+// The module path, used to find the version of this library in the build info
+const modulePath = "github.com/nadrojpeg/go-telegram-bot-api"
+
+// DefaultUserAgent is the User-Agent of the requests of Call and SaveFile, unless WithHeaders sets another one.
+// It includes the version of the library when the build info has it
+var DefaultUserAgent = "go-telegram-bot-api/" + moduleVersion()
+
+// Returns the version of this module the program was built with, or "devel"
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	if info.Main.Path == modulePath && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			return dep.Version
+		}
+	}
+	return "devel"
+}
+
+// WithHeaders returns a copy of client whose requests all carry the given headers,
+// replacing the values set by the library (like the User-Agent). A nil client means http.DefaultClient
+func WithHeaders(client *http.Client, header http.Header) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	c := *client
+	c.Transport = &headerTransport{base: client.Transport, header: header.Clone()}
+	return &c
+}
+
+// A RoundTripper that sets headers on every request and then passes it to base
+type headerTransport struct {
+	base   http.RoundTripper
+	header http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	// A RoundTripper must not modify the request it is given
+	req = req.Clone(req.Context())
+	for key, values := range t.header {
+		req.Header[http.CanonicalHeaderKey(key)] = values
+	}
+	return base.RoundTrip(req)
+}
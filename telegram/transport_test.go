@@ -0,0 +1,85 @@
+/* transport_test.go : tests for the headers of the outgoing requests
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// A client that records the headers of every request, answering like fakeFileServer
+func recordingClient(headers *[]http.Header) *http.Client {
+	return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		*headers = append(*headers, req.Header.Clone())
+		body := "hello"
+		if !strings.HasPrefix(req.URL.Path, "/file/") {
+			body = `{"ok":true,"result":{"file_id":"f1","file_unique_id":"u1","file_path":"a.txt"}}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})}
+}
+
+func TestDefaultUserAgent(t *testing.T) {
+	var headers []http.Header
+	client := recordingClient(&headers)
+	dest := filepath.Join(t.TempDir(), "a.txt")
+	if err := SaveFileByID(context.Background(), client, "123:abc", "f1", dest); err != nil {
+		t.Fatal(err)
+	}
+	if len(headers) != 2 {
+		t.Fatalf("%d requests, want getFile and the download", len(headers))
+	}
+	for i, h := range headers {
+		if got := h.Get("User-Agent"); got != DefaultUserAgent || !strings.HasPrefix(got, "go-telegram-bot-api/") {
+			t.Errorf("request %d has User-Agent %q, want %q", i, got, DefaultUserAgent)
+		}
+	}
+}
+
+func TestWithHeaders(t *testing.T) {
+	var headers []http.Header
+	base := recordingClient(&headers)
+	client := WithHeaders(base, http.Header{
+		"User-Agent":       {"mybot/1.2"},
+		"x-request-source": {"bots"},
+	})
+
+	if _, err := Call[File](context.Background(), client, "123:abc", "getFile", &GetFileParams{FileID: "f1"}); err != nil {
+		t.Fatal(err)
+	}
+	dest := filepath.Join(t.TempDir(), "a.txt")
+	if err := SaveFile(context.Background(), client, "123:abc", &File{FileID: "f1", FilePath: "a.txt"}, dest); err != nil {
+		t.Fatal(err)
+	}
+	if len(headers) != 2 {
+		t.Fatalf("%d requests, want 2", len(headers))
+	}
+	for i, h := range headers {
+		if got := h.Get("User-Agent"); got != "mybot/1.2" {
+			t.Errorf("request %d has User-Agent %q, want mybot/1.2", i, got)
+		}
+		if got := h.Get("X-Request-Source"); got != "bots" {
+			t.Errorf("request %d has X-Request-Source %q, want bots", i, got)
+		}
+	}
+	if got := headers[0].Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type %q, the other headers of the library must stay", got)
+	}
+
+	// The client given to WithHeaders is left as it was
+	headers = nil
+	if _, err := Call[File](context.Background(), base, "123:abc", "getFile", &GetFileParams{FileID: "f1"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := headers[0].Get("X-Request-Source"); got != "" {
+		t.Errorf("the base client sends X-Request-Source %q", got)
+	}
+}
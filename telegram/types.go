@@ -14,74 +14,76 @@
 
 package telegram
 
+import "encoding/json"
+
 // The Bot API sends an Update struct, which contains various nested structs.
 // We are building these complex structs, like Update and Message, from their fundamental components such as User and Chat.
 
 // This struct can represent both a user and a bot
 type User struct {
 	// Unique identifier for this user or bot
-	ID int64
+	ID int64 `json:"id"`
 
 	// True if the user is a bot
-	IsBot bool
+	IsBot bool `json:"is_bot"`
 
 	// User's or bot's first name
-	FirstName string
+	FirstName string `json:"first_name"`
 
 	// [Optional] User's or bot's last name
-	LastName string
+	LastName string `json:"last_name,omitempty"`
 
 	// [Optional] User's or bot's username
-	Username string
+	Username string `json:"username,omitempty"`
 
 	// [Optional] IETF language tag of the user's language
-	LanguageCode string
+	LanguageCode string `json:"language_code,omitempty"`
 
 	// [Optional] True if this user is a Telegram Premium user
-	IsPremium bool
+	IsPremium bool `json:"is_premium,omitempty"`
 
 	// [Optional] True if this user added the bot to the attachment menu
-	AddedToAttachmentMenu bool
+	AddedToAttachmentMenu bool `json:"added_to_attachment_menu,omitempty"`
 
 	// [Optional] True if the bot can be invited to groups. Returned only in GetMe
 
-	CanJoinGroups bool
+	CanJoinGroups bool `json:"can_join_groups,omitempty"`
 
 	// [Optional] True if privacy mode is disabled for the bot. Returned only in GetMe
-	CanReadAllGroupMessages bool
+	CanReadAllGroupMessages bool `json:"can_read_all_group_messages,omitempty"`
 
 	// [Optional] True if the bot supports inline queries. Returned only in GetMe
-	SupportsInlineQueries bool
+	SupportsInlineQueries bool `json:"supports_inline_queries,omitempty"`
 
 	// [Optional] True if the bot can be connected to a telegram business account to receive its messages. Returned only in GetMe
-	CanConnectToBusiness bool
+	CanConnectToBusiness bool `json:"can_connect_to_business,omitempty"`
 
 	// [Optional] True if the bot has a main Web App. Returned only in GetMe
-	HasMainWebApp bool     
+	HasMainWebApp bool `json:"has_main_web_app,omitempty"`
 }
 
 // This struct represents a chat
 type Chat struct {
 	// Unique identifier for this chat
-	ID int64
+	ID int64 `json:"id"`
 
 	// Type of the chat. Can be either "private", "group", "supergroup" or "channel"
-	Type string
+	Type string `json:"type"`
 
 	// [Optional] Title; for supergroups, channels and group chats
-	Title string
+	Title string `json:"title,omitempty"`
 
 	// [Optional] Username, for private chats, supergroups and channels if available
-	Username string
+	Username string `json:"username,omitempty"`
 
 	// [Optional] First name of the other party in a private chat
-	FirstName string
+	FirstName string `json:"first_name,omitempty"`
 
 	// [Optional] Last name of the other party in a private chat
-	LastName string
+	LastName string `json:"last_name,omitempty"`
 
 	// [Optional] True if the supergroup chat is a forum (has topics enabled)
-	IsForum bool
+	IsForum bool `json:"is_forum,omitempty"`
 }
 
 // This type represents a unique message identifier
@@ -90,23 +92,32 @@ type MessageID struct {
 	// the server might automatically schedule a message instead of sending it immediately.
 	// In such cases, this field will be 0 and the relevant message will be unusable until
 	// it is actually sent
-	MessageID int64
+	MessageID int64 `json:"message_id"`
 }
 
 // This struct descrives a message that was deleted or it is otherwise inaccessible to the bot
 type InaccessibleMessage struct {
 	// Chat the message belonged to
-	Chat Chat
+	Chat Chat `json:"chat"`
 
 	// Unique message identifier inside the chat
-	MessageID int64
+	MessageID int64 `json:"message_id"`
 
 	// Always zero. The field can be used to differentiate regular and inaccessible messages
-	Date int64
+	Date int64 `json:"date"`
 }
 
-// MaybeInaccessibleMessage
 // This is a Union of the types Message e InaccessibleMessage. Does golang have unions?
+// No, it doesn't. We use a struct with one pointer per member of the union: exactly one of them
+// is set after decoding. The JSON objects don't carry a "type" field, but an inaccessible
+// message always has date 0, so that's what UnmarshalJSON looks at (see unions.go)
+type MaybeInaccessibleMessage struct {
+	// Set if the message is accessible to the bot
+	Message *Message
+
+	// Set if the message was deleted or is otherwise inaccessible to the bot
+	InaccessibleMessage *InaccessibleMessage
+}
 
 // This struct represents one special entity in a text message.
 // For examples, hashtags, usernames, URLs, etc.
@@ -123,112 +134,3179 @@ type MessageEntity struct {
 	// "pre" (monowidth block), "text_link" (for clickable text URLs),
 	// "text_mention" (for users withous username),
 	// "custom_emoji" (for inline custom emoji stickers)
-	Type string
+	Type string `json:"type"`
 
 	// Offset in UTF-16 code units to the start of the entity
-	Offset int64
+	Offset int64 `json:"offset"`
 
 	// Length of the entity in UTF-16 code units
-	Length int64
+	Length int64 `json:"length"`
 
 	// [Optional] For "text_link" only, URL that will be opened after user taps on the text
-	URL string
+	URL string `json:"url,omitempty"`
 
 	// [Optional] For "text_mention" only, the mentioned user
-	User User
+	User *User `json:"user,omitempty"`
 
 	// [Optional] For "pre" only, the programming language of the entity text
-	Language string
+	Language string `json:"language,omitempty"`
 
 	// [Optional] For "custom_emoji" only, unique identifier of the custom emoji. Use GetCustomEmojiStickers to get full information about the sticker
-	CustomEmojiID string
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
 }
 
 // This struct contains information about the quoted part of a message that is replied to by the given message
 type TextQuote struct {
 	// Text of the quoted part of a message that is replied to by the given message
-	Text string
+	Text string `json:"text"`
 
 	// [Optional] Special entities that appear in the quote. Currently, only
 	// "bold", "italic", "underline", "strikethrough", "spoiler", and "custom_emoji"
 	// entities are kept in quotes
-	Entities []MessageEntity
+	Entities []MessageEntity `json:"entities,omitempty"`
 
 	// Approximate quote position in the original message in UTF-16 code units as specified by the sender
-	Position int64
+	Position int64 `json:"position"`
 
 	// [Optional] True if the quote was chisen manually by the message sender.
 	// Otherwise, the quote was added automatically by the server
-	IsManual bool
+	IsManual bool `json:"is_manual,omitempty"`
 }
 
 // This struct contains parameters for the message that is being sent
 type ReplyParameters struct {
 	// Identifier of the message that will be replied to in the current chat, or in the chat chat_id if it is specified
-	MessageID int64
+	MessageID int64 `json:"message_id"`
 
 	// [Optional] If the message to be replied to is from a different chat
 	// unique identifier for the chat or username of the channel (in the format
 	// @channelusername). Not supported for messages sent on behalf of a business
 	// account
-	// Should be Int or String, but Golang doesn't have union
-	// For now we use string, but I have to think carefully to this
-	ChatID string
+	// Should be Int or String, but Golang doesn't have union: see ChatID in chatid.go
+	ChatID ChatID `json:"chat_id,omitzero"`
 
 	// [Optional] Pass True if the message should be sent
 	// even if the specified message to be replied to is not found.
 	// Always False for replies in another chat or forum topic.
 	// Always True for messages sent on behalf of a business account
-	AllowSendingWithoutReply bool
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
 
 	// [Optional] Quoted part of the messages to be replied to; 0 - 1024
 	// characters after entities parsing. The quote must be an exact substring of
 	// the message to be replied to, including "bold", "italic", "underline",
 	// "strikethrough", "spoiler", and "custom_emoji" entities. The message
 	// will fail to send if the quote isn't found in the original message
-	Quote string
+	Quote string `json:"quote,omitempty"`
 
 	// [Optional] Mode for parsing entities in the quote. See formatting
 	// options for more details
-	QuoteParseMode string
+	QuoteParseMode ParseMode `json:"quote_parse_mode,omitempty"`
 
 	// [Optional] A JSON-serialized list of special entities that appear in
 	// the quote. It can be specified instead of quote_parse_mode
-	QuoteEntities []MessageEntity
+	QuoteEntities []MessageEntity `json:"quote_entities,omitempty"`
 
 	// [Optional] Position of the quote in the original message in UTF-16
 	// code units
-	QuotePosition Integer
+	QuotePosition int64 `json:"quote_position,omitempty"`
 }
 
-// MessageOrigin, another "union".
+// MessageOrigin, another "union", with the discriminator in the field "type":
 // - MessageOriginUser
 // - MessageOriginHiddenUser
 // - MessageOriginChat
 // - MessageOriginChannel
+type MessageOrigin struct {
+	User       *MessageOriginUser
+	HiddenUser *MessageOriginHiddenUser
+	Chat       *MessageOriginChat
+	Channel    *MessageOriginChannel
+
+	// The JSON of a kind this library doesn't know yet, when none of the members above is set
+	Unknown json.RawMessage
+}
 
 // This struct contains information in the case the message
 // was originally sent by a known user
 type MessageOriginUser struct {
 	// Type of the message origin, always "user"
-	Type string
+	Type string `json:"type"`
 
 	// Date the message was sent originally in Unix time
-	Date int64
+	Date int64 `json:"date"`
 
 	// User that sent the message originally
-	SenderUser User
+	SenderUser User `json:"sender_user"`
 }
 
 // This struct contains information in the case the message
 // was originally sent by an unknown user
 type MessageOriginHiddenUser struct {
-	// Type of the message origin, always "hidde_user"
-	Type string
+	// Type of the message origin, always "hidden_user"
+	Type string `json:"type"`
 
 	// Date the messahe was sent originally in Unix time
-	Date int64
+	Date int64 `json:"date"`
 
 	// Name of the user that sent the message originally
-	SendUserName string
+	SendUserName string `json:"sender_user_name"`
+}
+
+// This struct contains information in the case the message
+// was originally sent on behalf of a chat to a group chat
+type MessageOriginChat struct {
+	// Type of the message origin, always "chat"
+	Type string `json:"type"`
+
+	// Date the message was sent originally in Unix time
+	Date int64 `json:"date"`
+
+	// Chat that sent the message originally
+	SenderChat Chat `json:"sender_chat"`
+
+	// [Optional] For messages originally sent by an anonymous chat administrator, original message author signature
+	AuthorSignature string `json:"author_signature,omitempty"`
+}
+
+// This struct contains information in the case the message
+// was originally sent to a channel chat
+type MessageOriginChannel struct {
+	// Type of the message origin, always "channel"
+	Type string `json:"type"`
+
+	// Date the message was sent originally in Unix time
+	Date int64 `json:"date"`
+
+	// Channel chat to which the message was originally sent
+	Chat Chat `json:"chat"`
+
+	// Unique message identifier inside the chat
+	MessageID int64 `json:"message_id"`
+
+	// [Optional] Signature of the original post author
+	AuthorSignature string `json:"author_signature,omitempty"`
+}
+
+// This struct represents a story
+type Story struct {
+	// Chat that posted the story
+	Chat Chat `json:"chat"`
+
+	// Unique identifier for the story in the chat
+	ID int64 `json:"id"`
+}
+
+// This struct contains information about a message that is being replied to, which may come from another chat or forum topic.
+// It is incomplete too: the kinds of content this library doesn't model yet are ignored
+type ExternalReplyInfo struct {
+	// Origin of the message replied to by the given message
+	Origin MessageOrigin `json:"origin"`
+
+	// [Optional] Chat the original message belongs to. Available only if the chat is a supergroup or a channel
+	Chat *Chat `json:"chat,omitempty"`
+
+	// [Optional] Unique message identifier inside the original chat. Available only if the original chat is a supergroup or a channel
+	MessageID int64 `json:"message_id,omitempty"`
+
+	// [Optional] Message is an audio file, information about the file
+	Audio *Audio `json:"audio,omitempty"`
+
+	// [Optional] Message is a general file, information about the file
+	Document *Document `json:"document,omitempty"`
+
+	// [Optional] Message is a photo, available sizes of the photo
+	Photo []PhotoSize `json:"photo,omitempty"`
+
+	// [Optional] Message is a sticker, information about the sticker
+	Sticker *Sticker `json:"sticker,omitempty"`
+
+	// [Optional] Message is a forwarded story
+	Story *Story `json:"story,omitempty"`
+
+	// [Optional] Message is a video, information about the video
+	Video *Video `json:"video,omitempty"`
+
+	// [Optional] Message is a voice message, information about the file
+	Voice *Voice `json:"voice,omitempty"`
+
+	// [Optional] True, if the message media is covered by a spoiler animation
+	HasMediaSpoiler bool `json:"has_media_spoiler,omitempty"`
+
+	// [Optional] Message is a checklist
+	Checklist *Checklist `json:"checklist,omitempty"`
+
+	// [Optional] Message is a shared contact, information about the contact
+	Contact *Contact `json:"contact,omitempty"`
+
+	// [Optional] Message is a scheduled giveaway, information about the giveaway
+	Giveaway *Giveaway `json:"giveaway,omitempty"`
+
+	// [Optional] A giveaway with public winners was completed
+	GiveawayWinners *GiveawayWinners `json:"giveaway_winners,omitempty"`
+
+	// [Optional] Message is a shared location, information about the location
+	Location *Location `json:"location,omitempty"`
+
+	// [Optional] Message is a native poll, information about the poll
+	Poll *Poll `json:"poll,omitempty"`
+
+	// [Optional] Message is a venue, information about the venue
+	Venue *Venue `json:"venue,omitempty"`
+}
+
+// This struct represents one size of a photo or a file / sticker thumbnail
+type PhotoSize struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file
+	FileUniqueID string `json:"file_unique_id"`
+
+	// Photo width
+	Width int64 `json:"width"`
+
+	// Photo height
+	Height int64 `json:"height"`
+
+	// [Optional] File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// This struct represents a file ready to be downloaded.
+// The file can be downloaded via the link https://api.telegram.org/file/bot<token>/<file_path>.
+// It is guaranteed that the link will be valid for at least 1 hour
+type File struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, same meaning as in PhotoSize
+	FileUniqueID string `json:"file_unique_id"`
+
+	// [Optional] File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+
+	// [Optional] File path. Use https://api.telegram.org/file/bot<token>/<file_path> to get the file
+	FilePath string `json:"file_path,omitempty"`
+}
+
+// This struct represents an audio file to be treated as music by the Telegram clients
+type Audio struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, same meaning as in PhotoSize
+	FileUniqueID string `json:"file_unique_id"`
+
+	// Duration of the audio in seconds as defined by the sender
+	Duration int64 `json:"duration"`
+
+	// [Optional] Performer of the audio as defined by the sender or by audio tags
+	Performer string `json:"performer,omitempty"`
+
+	// [Optional] Title of the audio as defined by the sender or by audio tags
+	Title string `json:"title,omitempty"`
+
+	// [Optional] Original filename as defined by the sender
+	FileName string `json:"file_name,omitempty"`
+
+	// [Optional] MIME type of the file as defined by the sender
+	MimeType string `json:"mime_type,omitempty"`
+
+	// [Optional] File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+
+	// [Optional] Thumbnail of the album cover to which the music file belongs
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+}
+
+// This struct represents a video file
+type Video struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, same meaning as in PhotoSize
+	FileUniqueID string `json:"file_unique_id"`
+
+	// Video width as defined by the sender
+	Width int64 `json:"width"`
+
+	// Video height as defined by the sender
+	Height int64 `json:"height"`
+
+	// Duration of the video in seconds as defined by the sender
+	Duration int64 `json:"duration"`
+
+	// [Optional] Video thumbnail
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+
+	// [Optional] Available sizes of the cover of the video in the message
+	Cover []PhotoSize `json:"cover,omitempty"`
+
+	// [Optional] Timestamp in seconds from which the video will play in the message
+	StartTimestamp int64 `json:"start_timestamp,omitempty"`
+
+	// [Optional] Original filename as defined by the sender
+	FileName string `json:"file_name,omitempty"`
+
+	// [Optional] MIME type of the file as defined by the sender
+	MimeType string `json:"mime_type,omitempty"`
+
+	// [Optional] File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// This struct represents a general file (as opposed to photos, voice messages and audio files)
+type Document struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, same meaning as in PhotoSize
+	FileUniqueID string `json:"file_unique_id"`
+
+	// [Optional] Document thumbnail as defined by the sender
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+
+	// [Optional] Original filename as defined by the sender
+	FileName string `json:"file_name,omitempty"`
+
+	// [Optional] MIME type of the file as defined by the sender
+	MimeType string `json:"mime_type,omitempty"`
+
+	// [Optional] File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// This struct represents a voice note
+type Voice struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, same meaning as in PhotoSize
+	FileUniqueID string `json:"file_unique_id"`
+
+	// Duration of the audio in seconds as defined by the sender
+	Duration int64 `json:"duration"`
+
+	// [Optional] MIME type of the file as defined by the sender
+	MimeType string `json:"mime_type,omitempty"`
+
+	// [Optional] File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// This struct describes the position on faces where a mask should be placed by default
+type MaskPosition struct {
+	// The part of the face relative to which the mask should be placed. One of "forehead", "eyes", "mouth", or "chin"
+	Point string `json:"point"`
+
+	// Shift by X-axis measured in widths of the mask scaled to the face size, from left to right.
+	// For example, choosing -1.0 will place mask just to the left of the default mask position
+	XShift float64 `json:"x_shift"`
+
+	// Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom
+	YShift float64 `json:"y_shift"`
+
+	// Mask scaling coefficient. For example, 2.0 means double size
+	Scale float64 `json:"scale"`
+}
+
+// This struct represents a sticker
+type Sticker struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, same meaning as in PhotoSize
+	FileUniqueID string `json:"file_unique_id"`
+
+	// Type of the sticker, currently one of "regular", "mask", "custom_emoji".
+	// The type of the sticker is independent from its format, which is determined by the fields IsAnimated and IsVideo
+	Type string `json:"type"`
+
+	// Sticker width
+	Width int64 `json:"width"`
+
+	// Sticker height
+	Height int64 `json:"height"`
+
+	// True if the sticker is animated
+	IsAnimated bool `json:"is_animated"`
+
+	// True if the sticker is a video sticker
+	IsVideo bool `json:"is_video"`
+
+	// [Optional] Sticker thumbnail in the .WEBP or .JPG format
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+
+	// [Optional] Emoji associated with the sticker
+	Emoji string `json:"emoji,omitempty"`
+
+	// [Optional] Name of the sticker set to which the sticker belongs
+	SetName string `json:"set_name,omitempty"`
+
+	// [Optional] For premium regular stickers, premium animation for the sticker
+	PremiumAnimation *File `json:"premium_animation,omitempty"`
+
+	// [Optional] For mask stickers, the position where the mask should be placed
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+
+	// [Optional] For custom emoji stickers, unique identifier of the custom emoji
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+
+	// [Optional] True if the sticker must be repainted to a text color in messages,
+	// the color of the Telegram Premium badge in emoji status, white color on chat photos,
+	// or another appropriate color in other places
+	NeedsRepainting bool `json:"needs_repainting,omitempty"`
+
+	// [Optional] File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// This struct describes a sticker to be added to a sticker set
+type InputSticker struct {
+	// The added sticker. Pass a file_id as a String to send a file that already exists on the Telegram servers,
+	// pass an HTTP URL as a String for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>"
+	// to upload a new file using multipart/form-data under <file_attach_name> name.
+	// Animated and video stickers can't be uploaded via HTTP URL
+	Sticker string `json:"sticker"`
+
+	// Format of the added sticker, must be one of "static" for a .WEBP or .PNG image,
+	// "animated" for a .TGS animation, "video" for a .WEBM video
+	Format string `json:"format"`
+
+	// List of 1-20 emoji associated with the sticker
+	EmojiList []string `json:"emoji_list"`
+
+	// [Optional] Position where the mask should be placed on faces. For "mask" stickers only
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+
+	// [Optional] List of 0-20 search keywords for the sticker with total length of up to 64 characters.
+	// For "regular" and "custom_emoji" stickers only
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// This struct represents a task in a checklist
+type ChecklistTask struct {
+	// Unique identifier of the task
+	ID int64 `json:"id"`
+
+	// Text of the task
+	Text string `json:"text"`
+
+	// [Optional] Special entities that appear in the task text
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+
+	// [Optional] User that completed the task; omitted if the task wasn't completed
+	CompletedByUser *User `json:"completed_by_user,omitempty"`
+
+	// [Optional] Point in time (Unix timestamp) when the task was completed; 0 if the task wasn't completed
+	CompletionDate int64 `json:"completion_date,omitempty"`
+}
+
+// This struct represents a checklist
+type Checklist struct {
+	// Title of the checklist
+	Title string `json:"title"`
+
+	// [Optional] Special entities that appear in the checklist title
+	TitleEntities []MessageEntity `json:"title_entities,omitempty"`
+
+	// List of tasks in the checklist
+	Tasks []ChecklistTask `json:"tasks"`
+
+	// [Optional] True if users other than the creator of the list can add tasks to the list
+	OthersCanAddTasks bool `json:"others_can_add_tasks,omitempty"`
+
+	// [Optional] True if users other than the creator of the list can mark tasks as done or not done
+	OthersCanMarkTasksAsDone bool `json:"others_can_mark_tasks_as_done,omitempty"`
+}
+
+// This struct describes a task to add to a checklist (it is the "input" version of ChecklistTask)
+type InputChecklistTask struct {
+	// Unique identifier of the task; must be positive and unique among all task identifiers currently present in the checklist
+	ID int64 `json:"id"`
+
+	// Text of the task; 1-100 characters after entities parsing
+	Text string `json:"text"`
+
+	// [Optional] Mode for parsing entities in the text
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] List of special entities that appear in the text, which can be specified instead of parse_mode.
+	// Currently, only "bold", "italic", "underline", "strikethrough", "spoiler", and "custom_emoji" entities are allowed
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+}
+
+// This struct describes a checklist to create (it is the "input" version of Checklist)
+type InputChecklist struct {
+	// Title of the checklist; 1-255 characters after entities parsing
+	Title string `json:"title"`
+
+	// [Optional] Mode for parsing entities in the title
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] List of special entities that appear in the title, which can be specified instead of parse_mode.
+	// Same restrictions as InputChecklistTask.TextEntities
+	TitleEntities []MessageEntity `json:"title_entities,omitempty"`
+
+	// List of 1-30 tasks in the checklist
+	Tasks []InputChecklistTask `json:"tasks"`
+
+	// [Optional] Pass True if other users can add tasks to the checklist
+	OthersCanAddTasks bool `json:"others_can_add_tasks,omitempty"`
+
+	// [Optional] Pass True if other users can mark tasks as done or not done in the checklist
+	OthersCanMarkTasksAsDone bool `json:"others_can_mark_tasks_as_done,omitempty"`
+}
+
+// Parameters of the sendChecklist method, which sends a checklist on behalf of a connected business account.
+// It returns the sent Message
+type SendChecklistParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id"`
+
+	// Unique identifier for the target chat. Usernames are not accepted here
+	ChatID int64 `json:"chat_id"`
+
+	// The checklist to send
+	Checklist InputChecklist `json:"checklist"`
+
+	// [Optional] Sends the message silently. Users will receive a notification with no sound
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// [Optional] Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// [Optional] Unique identifier of the message effect to be added to the message
+	MessageEffectID string `json:"message_effect_id,omitempty"`
+
+	// [Optional] Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// [Optional] A JSON-serialized object for an inline keyboard
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Parameters of the editMessageChecklist method, which edits a checklist sent on behalf of a connected
+// business account. It returns the edited Message
+type EditMessageChecklistParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id"`
+
+	// Unique identifier for the target chat
+	ChatID int64 `json:"chat_id"`
+
+	// Unique identifier for the target message
+	MessageID int64 `json:"message_id"`
+
+	// The new checklist
+	Checklist InputChecklist `json:"checklist"`
+
+	// [Optional] A JSON-serialized object for the new inline keyboard for the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// This struct describes a service message about checklist tasks marked as done or not done
+type ChecklistTasksDone struct {
+	// [Optional] Message containing the checklist whose tasks were marked as done or not done.
+	// Note that the Message object in this field will not contain the reply_to_message field even if it itself is a reply
+	ChecklistMessage *Message `json:"checklist_message,omitempty"`
+
+	// [Optional] Identifiers of the tasks that were marked as done
+	MarkedAsDoneTaskIDs []int64 `json:"marked_as_done_task_ids,omitempty"`
+
+	// [Optional] Identifiers of the tasks that were marked as not done
+	MarkedAsNotDoneTaskIDs []int64 `json:"marked_as_not_done_task_ids,omitempty"`
+}
+
+// This struct describes a service message about tasks added to a checklist
+type ChecklistTasksAdded struct {
+	// [Optional] Message containing the checklist to which the tasks were added.
+	// Same caveat as ChecklistTasksDone.ChecklistMessage
+	ChecklistMessage *Message `json:"checklist_message,omitempty"`
+
+	// List of tasks added to the checklist
+	Tasks []ChecklistTask `json:"tasks"`
+}
+
+// This struct represents a point on the map
+type Location struct {
+	// Latitude as defined by the sender
+	Latitude float64 `json:"latitude"`
+
+	// Longitude as defined by the sender
+	Longitude float64 `json:"longitude"`
+
+	// [Optional] The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// [Optional] Time relative to the message sending date, during which the location can be updated; in seconds.
+	// For active live locations only: the updates arrive as edited_message (or edited_channel_post) with the new coordinates
+	LivePeriod int64 `json:"live_period,omitempty"`
+
+	// [Optional] The direction in which user is moving, in degrees; 1-360. For active live locations only
+	Heading int64 `json:"heading,omitempty"`
+
+	// [Optional] The maximum distance for proximity alerts about approaching another chat member, in meters. For sent live locations only
+	ProximityAlertRadius int64 `json:"proximity_alert_radius,omitempty"`
+}
+
+// This struct represents a venue
+type Venue struct {
+	// Venue location. Can't be a live location
+	Location Location `json:"location"`
+
+	// Name of the venue
+	Title string `json:"title"`
+
+	// Address of the venue
+	Address string `json:"address"`
+
+	// [Optional] Foursquare identifier of the venue
+	FoursquareID string `json:"foursquare_id,omitempty"`
+
+	// [Optional] Foursquare type of the venue (for example, "arts_entertainment/default", "arts_entertainment/aquarium" or "food/icecream")
+	FoursquareType string `json:"foursquare_type,omitempty"`
+
+	// [Optional] Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+
+	// [Optional] Google Places type of the venue
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+}
+
+// This struct represents a phone contact
+type Contact struct {
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+
+	// Contact's first name
+	FirstName string `json:"first_name"`
+
+	// [Optional] Contact's last name
+	LastName string `json:"last_name,omitempty"`
+
+	// [Optional] Contact's user identifier in Telegram. Set only if the contact is a Telegram user
+	UserID int64 `json:"user_id,omitempty"`
+
+	// [Optional] Additional data about the contact in the form of a vCard
+	VCard string `json:"vcard,omitempty"`
+}
+
+// This struct represents a shipping address
+type ShippingAddress struct {
+	// Two-letter ISO 3166-1 alpha-2 country code
+	CountryCode string `json:"country_code"`
+
+	// State, if applicable
+	State string `json:"state"`
+
+	// City
+	City string `json:"city"`
+
+	// First line for the address
+	StreetLine1 string `json:"street_line1"`
+
+	// Second line for the address
+	StreetLine2 string `json:"street_line2"`
+
+	// Address post code
+	PostCode string `json:"post_code"`
+}
+
+// This struct represents information about an order
+type OrderInfo struct {
+	// [Optional] User name
+	Name string `json:"name,omitempty"`
+
+	// [Optional] User's phone number
+	PhoneNumber string `json:"phone_number,omitempty"`
+
+	// [Optional] User email
+	Email string `json:"email,omitempty"`
+
+	// [Optional] User shipping address
+	ShippingAddress *ShippingAddress `json:"shipping_address,omitempty"`
+}
+
+// This struct contains basic information about a successful payment.
+// Note that if the buyer initiates a chargeback with the relevant payment provider following this transaction,
+// the funds may be debited from your balance. This is outside of Telegram's control
+type SuccessfulPayment struct {
+	// Three-letter ISO 4217 currency code, or "XTR" for payments in Telegram Stars
+	Currency string `json:"currency"`
+
+	// Total price in the smallest units of the currency (integer, not float/double).
+	// For example, for a price of US$ 1.45 pass amount = 145.
+	// See the exp parameter in currencies.json, it shows the number of digits past the decimal point for each currency
+	TotalAmount int64 `json:"total_amount"`
+
+	// Bot-specified invoice payload
+	InvoicePayload string `json:"invoice_payload"`
+
+	// [Optional] Expiration date of the subscription, in Unix time; for recurring payments only
+	SubscriptionExpirationDate int64 `json:"subscription_expiration_date,omitempty"`
+
+	// [Optional] True, if the payment is a recurring payment for a subscription
+	IsRecurring bool `json:"is_recurring,omitempty"`
+
+	// [Optional] True, if the payment is the first payment for a subscription
+	IsFirstRecurring bool `json:"is_first_recurring,omitempty"`
+
+	// [Optional] Identifier of the shipping option chosen by the user
+	ShippingOptionID string `json:"shipping_option_id,omitempty"`
+
+	// [Optional] Order information provided by the user
+	OrderInfo *OrderInfo `json:"order_info,omitempty"`
+
+	// Telegram payment identifier. Keep it: refundStarPayment and editUserStarSubscription want it
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+
+	// Provider payment identifier
+	ProviderPaymentChargeID string `json:"provider_payment_charge_id"`
+}
+
+// This struct represents a portion of the price for goods or services
+type LabeledPrice struct {
+	// Portion label
+	Label string `json:"label"`
+
+	// Price of the product in the smallest units of the currency (integer, not float/double), same as SuccessfulPayment.TotalAmount
+	Amount int64 `json:"amount"`
+}
+
+// This struct represents one shipping option
+type ShippingOption struct {
+	// Shipping option identifier
+	ID string `json:"id"`
+
+	// Option title
+	Title string `json:"title"`
+
+	// List of price portions
+	Prices []LabeledPrice `json:"prices"`
+}
+
+// This struct contains information about an incoming shipping query, sent for invoices with flexible prices
+type ShippingQuery struct {
+	// Unique query identifier
+	ID string `json:"id"`
+
+	// User who sent the query
+	From User `json:"from"`
+
+	// Bot-specified invoice payload
+	InvoicePayload string `json:"invoice_payload"`
+
+	// User specified shipping address
+	ShippingAddress ShippingAddress `json:"shipping_address"`
+}
+
+// This struct describes why a request was unsuccessful
+type ResponseParameters struct {
+	// [Optional] The group has been migrated to a supergroup with the specified identifier
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+
+	// [Optional] In case of exceeding flood control, the number of seconds left to wait before the request can be repeated
+	RetryAfter int64 `json:"retry_after,omitempty"`
+}
+
+// This struct represents a service message about a user allowing a bot to write messages after adding it to the attachment menu,
+// launching a Web App from a link, or accepting an explicit request from a Web App sent by the method requestWriteAccess
+type WriteAccessAllowed struct {
+	// [Optional] True, if the access was granted after the user accepted an explicit request from a Web App sent by the method requestWriteAccess
+	FromRequest bool `json:"from_request,omitempty"`
+
+	// [Optional] Name of the Web App, if the access was granted when the Web App was launched from a link
+	WebAppName string `json:"web_app_name,omitempty"`
+
+	// [Optional] True, if the access was granted when the bot was added to the attachment or side menu
+	FromAttachmentMenu bool `json:"from_attachment_menu,omitempty"`
+}
+
+// This struct represents the content of a service message, sent whenever a user in the chat triggers
+// a proximity alert set by another user
+type ProximityAlertTriggered struct {
+	// User that triggered the alert
+	Traveler User `json:"traveler"`
+
+	// User that set the alert
+	Watcher User `json:"watcher"`
+
+	// The distance between the users
+	Distance int64 `json:"distance"`
+}
+
+// This struct represents a service message about a video chat scheduled in the chat
+type VideoChatScheduled struct {
+	// Point in time (Unix timestamp) when the video chat is supposed to be started by a chat administrator
+	StartDate int64 `json:"start_date"`
+}
+
+// This struct represents a service message about a video chat started in the chat. Currently holds no information
+type VideoChatStarted struct{}
+
+// This struct represents a service message about a video chat ended in the chat
+type VideoChatEnded struct {
+	// Video chat duration in seconds
+	Duration int64 `json:"duration"`
+}
+
+// This struct represents a service message about new members invited to a video chat
+type VideoChatParticipantsInvited struct {
+	// New members that were invited to the video chat
+	Users []User `json:"users"`
+}
+
+// This struct represents a message.
+// It is far from complete: fields are added as the wrapper needs them
+type Message struct {
+	// Unique message identifier inside the chat
+	MessageID int64 `json:"message_id"`
+
+	// [Optional] Unique identifier of a message thread or forum topic to which the message belongs; for supergroups and private chats only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+
+	// [Optional] Sender of the message; may be empty for messages sent to channels. For backward compatibility,
+	// if the message was sent on behalf of a chat, the field contains a fake sender user in non-channel chats
+	From *User `json:"from,omitempty"`
+
+	// [Optional] Sender of the message when sent on behalf of a chat. For example, the supergroup itself for messages
+	// sent by its anonymous administrators or a linked channel for messages automatically forwarded to the channel's
+	// discussion group. For backward compatibility, if the message was sent on behalf of a chat, the field from contains
+	// a fake sender user in non-channel chats
+	SenderChat *Chat `json:"sender_chat,omitempty"`
+
+	// Date the message was sent in Unix time. It is always a positive number, representing a valid date
+	// (see InaccessibleMessage to understand why this is important)
+	Date int64 `json:"date"`
+
+	// Chat the message belongs to
+	Chat Chat `json:"chat"`
+
+	// [Optional] True, if the message is sent to a topic in a forum supergroup or a private chat with the bot
+	IsTopicMessage bool `json:"is_topic_message,omitempty"`
+
+	// [Optional] The bot that actually sent the message on behalf of the business account.
+	// Available only for outgoing messages sent on behalf of the connected business account
+	SenderBusinessBot *User `json:"sender_business_bot,omitempty"`
+
+	// [Optional] Unique identifier of the business connection from which the message was received.
+	// If non-empty, the message belongs to a chat of the corresponding business account that is independent
+	// from any potential bot chat which might share the same identifier
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// [Optional] Information about the message that is being replied to, which may come from another chat or forum topic
+	ExternalReply *ExternalReplyInfo `json:"external_reply,omitempty"`
+
+	// [Optional] For replies that quote part of the original message, the quoted part of the message
+	Quote *TextQuote `json:"quote,omitempty"`
+
+	// [Optional] For replies to a story, the original story
+	ReplyToStory *Story `json:"reply_to_story,omitempty"`
+
+	// [Optional] Bot through which the message was sent (with an inline query)
+	ViaBot *User `json:"via_bot,omitempty"`
+
+	// [Optional] True, if the message can't be forwarded
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
+
+	// [Optional] Unique identifier of the message effect added to the message
+	EffectID string `json:"effect_id,omitempty"`
+
+	// [Optional] The group has been migrated to a supergroup with the specified identifier
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+
+	// [Optional] The supergroup has been migrated from a group with the specified identifier
+	MigrateFromChatID int64 `json:"migrate_from_chat_id,omitempty"`
+
+	// [Optional] Date the message was last edited in Unix time.
+	// We can't call it EditDate because the method with that name returns it as a time.Time
+	EditDateUnix int64 `json:"edit_date,omitempty"`
+
+	// [Optional] For text messages, the actual UTF-8 text of the message
+	Text string `json:"text,omitempty"`
+
+	// [Optional] For text messages, special entities like usernames, URLs, bot commands, etc. that appear in the text
+	Entities []MessageEntity `json:"entities,omitempty"`
+
+	// [Optional] Caption for the animation, audio, document, paid media, photo, video or voice
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] For messages with a caption, special entities like usernames, URLs, bot commands, etc. that appear in the caption
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] Specified message was pinned. Note that the Message object in this field
+	// will not contain further reply_to_message fields even if it itself is a reply.
+	// The pinned message may have been deleted in the meantime, hence MaybeInaccessibleMessage
+	PinnedMessage *MaybeInaccessibleMessage `json:"pinned_message,omitempty"`
+
+	// [Optional] The unique identifier of a media message group this message belongs to.
+	// All the messages of an album have the same one
+	MediaGroupID string `json:"media_group_id,omitempty"`
+
+	// [Optional] Message is a photo, available sizes of the photo
+	Photo []PhotoSize `json:"photo,omitempty"`
+
+	// [Optional] Message is a video, information about the video
+	Video *Video `json:"video,omitempty"`
+
+	// [Optional] Message is a general file, information about the file
+	Document *Document `json:"document,omitempty"`
+
+	// [Optional] Message is an audio file, information about the file
+	Audio *Audio `json:"audio,omitempty"`
+
+	// [Optional] Message is a voice message, information about the file
+	Voice *Voice `json:"voice,omitempty"`
+
+	// [Optional] Message is a shared contact, information about the contact
+	Contact *Contact `json:"contact,omitempty"`
+
+	// [Optional] Message is a shared location, information about the location
+	Location *Location `json:"location,omitempty"`
+
+	// [Optional] Message is a venue, information about the venue. For backward compatibility, when this field is set, the Location field will also be set
+	Venue *Venue `json:"venue,omitempty"`
+
+	// [Optional] Message is a native poll, information about the poll
+	Poll *Poll `json:"poll,omitempty"`
+
+	// [Optional] Message is a service message about a successful payment, information about the payment
+	SuccessfulPayment *SuccessfulPayment `json:"successful_payment,omitempty"`
+
+	// [Optional] Service message: the user allowed the bot to write messages after adding it to the attachment or side menu,
+	// launching a Web App from a link, or accepting an explicit request from a Web App sent by the method requestWriteAccess
+	WriteAccessAllowed *WriteAccessAllowed `json:"write_access_allowed,omitempty"`
+
+	// [Optional] Service message. A user in the chat triggered another user's proximity alert while sharing Live Location
+	ProximityAlertTriggered *ProximityAlertTriggered `json:"proximity_alert_triggered,omitempty"`
+
+	// [Optional] Service message: video chat scheduled
+	VideoChatScheduled *VideoChatScheduled `json:"video_chat_scheduled,omitempty"`
+
+	// [Optional] Service message: video chat started
+	VideoChatStarted *VideoChatStarted `json:"video_chat_started,omitempty"`
+
+	// [Optional] Service message: video chat ended
+	VideoChatEnded *VideoChatEnded `json:"video_chat_ended,omitempty"`
+
+	// [Optional] Service message: new participants invited to a video chat
+	VideoChatParticipantsInvited *VideoChatParticipantsInvited `json:"video_chat_participants_invited,omitempty"`
+
+	// [Optional] Message is a checklist
+	Checklist *Checklist `json:"checklist,omitempty"`
+
+	// [Optional] Service message: some tasks in a checklist were marked as done or not done
+	ChecklistTasksDone *ChecklistTasksDone `json:"checklist_tasks_done,omitempty"`
+
+	// [Optional] Service message: tasks were added to a checklist
+	ChecklistTasksAdded *ChecklistTasksAdded `json:"checklist_tasks_added,omitempty"`
+
+	// [Optional] Service message: a scheduled giveaway was created
+	GiveawayCreated *GiveawayCreated `json:"giveaway_created,omitempty"`
+
+	// [Optional] The message is a scheduled giveaway message
+	Giveaway *Giveaway `json:"giveaway,omitempty"`
+
+	// [Optional] A giveaway with public winners was completed
+	GiveawayWinners *GiveawayWinners `json:"giveaway_winners,omitempty"`
+
+	// [Optional] Service message: a giveaway without public winners was completed
+	GiveawayCompleted *GiveawayCompleted `json:"giveaway_completed,omitempty"`
+}
+
+// This struct represents one button of an inline keyboard.
+// Exactly one of the optional fields must be used to specify type of the button
+type InlineKeyboardButton struct {
+	// Label text on the button
+	Text string `json:"text"`
+
+	// [Optional] HTTP or tg:// URL to be opened when the button is pressed.
+	// Links tg://user?id=<user_id> can be used to mention a user by their identifier without using a username,
+	// if this is allowed by their privacy settings
+	URL string `json:"url,omitempty"`
+
+	// [Optional] Data to be sent in a callback query to the bot when the button is pressed, 1-64 bytes
+	CallbackData string `json:"callback_data,omitempty"`
+
+	// [Optional] If set, pressing the button will prompt the user to select one of their chats,
+	// open that chat and insert the bot's username and the specified inline query in the input field.
+	// May be empty, in which case just the bot's username will be inserted.
+	// A pointer, because the empty string is a meaningful value here
+	SwitchInlineQuery *string `json:"switch_inline_query,omitempty"`
+
+	// [Optional] If set, pressing the button will insert the bot's username and the specified inline query
+	// in the current chat's input field. May be empty, in which case only the bot's username will be inserted
+	SwitchInlineQueryCurrentChat *string `json:"switch_inline_query_current_chat,omitempty"`
+
+	// [Optional] Specify True, to send a Pay button. Substrings "⭐" and "XTR" in the buttons's text will be replaced with a Telegram Star icon.
+	// This type of button must always be the first button in the first row and can only be used in invoice messages
+	Pay bool `json:"pay,omitempty"`
+}
+
+// This struct represents an inline keyboard that appears right next to the message it belongs to
+type InlineKeyboardMarkup struct {
+	// Array of button rows, each represented by an Array of InlineKeyboardButton objects
+	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+// This struct represents type of a poll which is allowed to be created and sent when the corresponding button is pressed
+type KeyboardButtonPollType struct {
+	// [Optional] If "quiz" is passed, the user will be allowed to create only polls in the quiz mode.
+	// If "regular" is passed, only regular polls will be allowed. Otherwise, the user will be allowed to create a poll of any type
+	Type string `json:"type,omitempty"`
+}
+
+// This struct represents one button of the reply keyboard.
+// At most one of the optional fields must be used to specify type of the button.
+// For simple text buttons, just the Text can be used
+type KeyboardButton struct {
+	// Text of the button. If none of the optional fields are used, it will be sent as a message when the button is pressed
+	Text string `json:"text"`
+
+	// [Optional] If True, the user's phone number will be sent as a contact when the button is pressed. Available in private chats only
+	RequestContact bool `json:"request_contact,omitempty"`
+
+	// [Optional] If True, the user's current location will be sent when the button is pressed. Available in private chats only
+	RequestLocation bool `json:"request_location,omitempty"`
+
+	// [Optional] If specified, the user will be asked to create a poll and send it to the bot when the button is pressed.
+	// Available in private chats only
+	RequestPoll *KeyboardButtonPollType `json:"request_poll,omitempty"`
+}
+
+// This struct represents a custom keyboard with reply options
+type ReplyKeyboardMarkup struct {
+	// Array of button rows, each represented by an Array of KeyboardButton objects
+	Keyboard [][]KeyboardButton `json:"keyboard"`
+
+	// [Optional] Requests clients to always show the keyboard when the regular keyboard is hidden
+	IsPersistent bool `json:"is_persistent,omitempty"`
+
+	// [Optional] Requests clients to resize the keyboard vertically for optimal fit
+	// (e.g., make the keyboard smaller if there are just two rows of buttons)
+	ResizeKeyboard bool `json:"resize_keyboard,omitempty"`
+
+	// [Optional] Requests clients to hide the keyboard as soon as it's been used.
+	// The keyboard will still be available, but clients will automatically display the usual letter-keyboard in the chat
+	OneTimeKeyboard bool `json:"one_time_keyboard,omitempty"`
+
+	// [Optional] The placeholder to be shown in the input field when the keyboard is active; 1-64 characters
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+
+	// [Optional] Use this parameter if you want to show the keyboard to specific users only:
+	// users that are @mentioned in the text of the message, and the sender of the message the bot is replying to
+	Selective bool `json:"selective,omitempty"`
+}
+
+// Upon receiving a message with this object, Telegram clients will remove the current custom keyboard
+// and display the default letter-keyboard
+type ReplyKeyboardRemove struct {
+	// Requests clients to remove the custom keyboard. Always true, it is filled in automatically when sent as a ReplyMarkup
+	RemoveKeyboard bool `json:"remove_keyboard"`
+
+	// [Optional] Use this parameter if you want to remove the keyboard for specific users only, same as ReplyKeyboardMarkup.Selective
+	Selective bool `json:"selective,omitempty"`
+}
+
+// Upon receiving a message with this object, Telegram clients will display a reply interface to the user
+// (act as if the user has selected the bot's message and tapped "Reply")
+type ForceReply struct {
+	// Shows reply interface to the user. Always true, it is filled in automatically when sent as a ReplyMarkup
+	ForceReply bool `json:"force_reply"`
+
+	// [Optional] The placeholder to be shown in the input field when the reply is active; 1-64 characters
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+
+	// [Optional] Use this parameter if you want to force reply from specific users only, same as ReplyKeyboardMarkup.Selective
+	Selective bool `json:"selective,omitempty"`
+}
+
+// ReplyMarkup, a "union" for the reply_markup of the send methods:
+// - InlineKeyboardMarkup
+// - ReplyKeyboardMarkup
+// - ReplyKeyboardRemove
+// - ForceReply
+// Like InputMedia, it is a struct with one pointer per member: set at most one. The zero value sends no markup
+type ReplyMarkup struct {
+	Inline     *InlineKeyboardMarkup
+	Keyboard   *ReplyKeyboardMarkup
+	Remove     *ReplyKeyboardRemove
+	ForceReply *ForceReply
+}
+
+// This struct contains information about one answer option in a poll
+type PollOption struct {
+	// Option text, 1-100 characters
+	Text string `json:"text"`
+
+	// [Optional] Special entities that appear in the option text. Currently, only custom emoji entities are allowed in poll option texts
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+
+	// Number of users that voted for this option
+	VoterCount int64 `json:"voter_count"`
+}
+
+// This struct contains information about one answer option in a poll to be sent
+type InputPollOption struct {
+	// Option text, 1-100 characters
+	Text string `json:"text"`
+
+	// [Optional] Mode for parsing entities in the text. Currently, only custom emoji entities are allowed
+	TextParseMode ParseMode `json:"text_parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the poll option text. It can be specified instead of text_parse_mode
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+}
+
+// This struct contains information about a poll
+type Poll struct {
+	// Unique poll identifier
+	ID string `json:"id"`
+
+	// Poll question, 1-300 characters
+	Question string `json:"question"`
+
+	// [Optional] Special entities that appear in the question. Currently, only custom emoji entities are allowed in poll questions
+	QuestionEntities []MessageEntity `json:"question_entities,omitempty"`
+
+	// List of poll options
+	Options []PollOption `json:"options"`
+
+	// Total number of users that voted in the poll
+	TotalVoterCount int64 `json:"total_voter_count"`
+
+	// True, if the poll is closed
+	IsClosed bool `json:"is_closed"`
+
+	// True, if the poll is anonymous
+	IsAnonymous bool `json:"is_anonymous"`
+
+	// Poll type, currently can be "regular" or "quiz"
+	Type string `json:"type"`
+
+	// True, if the poll allows multiple answers
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers"`
+
+	// [Optional] 0-based identifier of the correct answer option. Available only for polls in the quiz mode,
+	// which are closed, or was sent (not forwarded) by the bot or to the private chat with the bot.
+	// A pointer, because 0 is a valid option
+	CorrectOptionID *int64 `json:"correct_option_id,omitempty"`
+
+	// [Optional] Text that is shown when a user chooses an incorrect answer or taps on the lamp icon in a quiz-style poll, 0-200 characters
+	Explanation string `json:"explanation,omitempty"`
+
+	// [Optional] Special entities like usernames, URLs, bot commands, etc. that appear in the explanation
+	ExplanationEntities []MessageEntity `json:"explanation_entities,omitempty"`
+
+	// [Optional] Amount of time in seconds the poll will be active after creation
+	OpenPeriod int64 `json:"open_period,omitempty"`
+
+	// [Optional] Point in time (Unix timestamp) when the poll will be automatically closed
+	CloseDate int64 `json:"close_date,omitempty"`
+}
+
+// This struct contains full information about a chat (the result of getChat).
+// It starts with the same fields as Chat
+type ChatFullInfo struct {
+	// Unique identifier for this chat
+	ID int64 `json:"id"`
+
+	// Type of the chat. Can be either "private", "group", "supergroup" or "channel"
+	Type string `json:"type"`
+
+	// [Optional] Title; for supergroups, channels and group chats
+	Title string `json:"title,omitempty"`
+
+	// [Optional] Username, for private chats, supergroups and channels if available
+	Username string `json:"username,omitempty"`
+
+	// [Optional] First name of the other party in a private chat
+	FirstName string `json:"first_name,omitempty"`
+
+	// [Optional] Last name of the other party in a private chat
+	LastName string `json:"last_name,omitempty"`
+
+	// [Optional] True if the supergroup chat is a forum (has topics enabled)
+	IsForum bool `json:"is_forum,omitempty"`
+
+	// [Optional] Bio of the other party in a private chat
+	Bio string `json:"bio,omitempty"`
+
+	// [Optional] Description, for groups, supergroups and channel chats
+	Description string `json:"description,omitempty"`
+
+	// [Optional] Primary invite link, for groups, supergroups and channel chats
+	InviteLink string `json:"invite_link,omitempty"`
+
+	// [Optional] The most recent pinned message (by sending date). It may have been deleted, hence MaybeInaccessibleMessage
+	PinnedMessage *MaybeInaccessibleMessage `json:"pinned_message,omitempty"`
+
+	// [Optional] For supergroups, the minimum allowed delay between consecutive messages sent by each unprivileged user; in seconds
+	SlowModeDelay int64 `json:"slow_mode_delay,omitempty"`
+
+	// [Optional] The time after which all messages sent to the chat will be automatically deleted; in seconds
+	MessageAutoDeleteTime int64 `json:"message_auto_delete_time,omitempty"`
+
+	// [Optional] For supergroups, name of the group sticker set
+	StickerSetName string `json:"sticker_set_name,omitempty"`
+
+	// [Optional] True, if the bot can change the group sticker set
+	CanSetStickerSet bool `json:"can_set_sticker_set,omitempty"`
+
+	// [Optional] Unique identifier for the linked chat, i.e. the discussion group identifier for a channel and vice versa;
+	// for supergroups and channel chats.
+	// It is "Raw" because the LinkedChatID method tells whether it is present
+	RawLinkedChatID int64 `json:"linked_chat_id,omitempty"`
+
+	// Identifier of the accent color for the chat name and backgrounds of the chat photo, reply header, and link preview
+	AccentColorID int64 `json:"accent_color_id"`
+
+	// The maximum number of reactions that can be set on a message in the chat
+	MaxReactionCount int64 `json:"max_reaction_count"`
+
+	// [Optional] List of available reactions allowed in the chat. If omitted (nil), then all emoji reactions are allowed,
+	// while an empty list means that no reaction is allowed. See AllowsReaction
+	AvailableReactions []ReactionType `json:"available_reactions,omitzero"`
+
+	// [Optional] Custom emoji identifier of the emoji chosen by the chat for the reply header and link preview background
+	BackgroundCustomEmojiID string `json:"background_custom_emoji_id,omitempty"`
+
+	// [Optional] Identifier of the accent color for the chat's profile background
+	ProfileAccentColorID int64 `json:"profile_accent_color_id,omitempty"`
+
+	// [Optional] Custom emoji identifier of the emoji chosen by the chat for its profile background
+	ProfileBackgroundCustomEmojiID string `json:"profile_background_custom_emoji_id,omitempty"`
+
+	// [Optional] True, if users need to join the supergroup before they can send messages
+	JoinToSendMessages bool `json:"join_to_send_messages,omitempty"`
+
+	// [Optional] True, if all users directly joining the supergroup without using an invite link need to be approved by supergroup administrators
+	JoinByRequest bool `json:"join_by_request,omitempty"`
+
+	// [Optional] True, if aggressive anti-spam checks are enabled in the supergroup. The field is only available to chat administrators
+	HasAggressiveAntiSpamEnabled bool `json:"has_aggressive_anti_spam_enabled,omitempty"`
+
+	// [Optional] True, if non-administrators can only get the list of bots and administrators in the chat
+	HasHiddenMembers bool `json:"has_hidden_members,omitempty"`
+
+	// [Optional] True, if messages from the chat can't be forwarded to other chats
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
+
+	// [Optional] True, if new chat members will have access to old messages; available only to chat administrators
+	HasVisibleHistory bool `json:"has_visible_history,omitempty"`
+}
+
+// ReactionType, another "union" with a discriminator, the field "type":
+// - ReactionTypeEmoji
+// - ReactionTypeCustomEmoji
+// - ReactionTypePaid
+type ReactionType struct {
+	Emoji       *ReactionTypeEmoji
+	CustomEmoji *ReactionTypeCustomEmoji
+	Paid        *ReactionTypePaid
+
+	// The JSON of a kind this library doesn't know yet, when none of the members above is set
+	Unknown json.RawMessage
+}
+
+// The reaction is based on an emoji
+type ReactionTypeEmoji struct {
+	// Type of the reaction, always "emoji"
+	Type string `json:"type"`
+
+	// Reaction emoji. Only a fixed list of emoji is accepted, see reactions.go
+	Emoji string `json:"emoji"`
+}
+
+// The reaction is based on a custom emoji
+type ReactionTypeCustomEmoji struct {
+	// Type of the reaction, always "custom_emoji"
+	Type string `json:"type"`
+
+	// Custom emoji identifier
+	CustomEmojiID string `json:"custom_emoji_id"`
+}
+
+// The reaction is paid
+type ReactionTypePaid struct {
+	// Type of the reaction, always "paid"
+	Type string `json:"type"`
+}
+
+// This struct represents an incoming callback query from a callback button in an inline keyboard.
+// If the button that originated the query was attached to a message sent by the bot, the field Message will be present.
+// If the button was attached to a message sent via the bot (in inline mode), the field InlineMessageID will be present.
+// Exactly one of the fields Data or GameShortName will be present
+type CallbackQuery struct {
+	// Unique identifier for this query
+	ID string `json:"id"`
+
+	// Sender
+	From User `json:"from"`
+
+	// [Optional] Message sent by the bot with the callback button that originated the query.
+	// It can be inaccessible if it was deleted or is too old
+	Message *MaybeInaccessibleMessage `json:"message,omitempty"`
+
+	// [Optional] Identifier of the message sent via the bot in inline mode, that originated the query
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	// Global identifier, uniquely corresponding to the chat to which the message with the callback button was sent.
+	// Useful for high scores in games
+	ChatInstance string `json:"chat_instance"`
+
+	// [Optional] Data associated with the callback button.
+	// Be aware that the message originated the query can contain no callback buttons with this data
+	Data string `json:"data,omitempty"`
+
+	// [Optional] Short name of a Game to be returned, serves as the unique identifier for the game
+	GameShortName string `json:"game_short_name,omitempty"`
+}
+
+// This struct represents an incoming inline query.
+// When the user sends an empty query, your bot could return some default or trending results
+type InlineQuery struct {
+	// Unique identifier for this query
+	ID string `json:"id"`
+
+	// Sender
+	From User `json:"from"`
+
+	// Text of the query (up to 256 characters)
+	Query string `json:"query"`
+
+	// Offset of the results to be returned, can be controlled by the bot
+	Offset string `json:"offset"`
+
+	// [Optional] Type of the chat from which the inline query was sent. Can be either "sender" for a private chat
+	// with the inline query sender, "private", "group", "supergroup", or "channel".
+	// The chat type should be always known for requests sent from official clients and most third-party clients,
+	// unless the request was sent from a secret chat
+	ChatType string `json:"chat_type,omitempty"`
+}
+
+// ChatBoostSource, a "union" with a discriminator this time: the field "source"
+// - ChatBoostSourcePremium
+// - ChatBoostSourceGiftCode
+// - ChatBoostSourceGiveaway
+type ChatBoostSource struct {
+	Premium  *ChatBoostSourcePremium
+	GiftCode *ChatBoostSourceGiftCode
+	Giveaway *ChatBoostSourceGiveaway
+
+	// The JSON of a kind this library doesn't know yet, when none of the members above is set
+	Unknown json.RawMessage
+}
+
+// The boost was obtained by subscribing to Telegram Premium or by gifting a Telegram Premium subscription to another user
+type ChatBoostSourcePremium struct {
+	// Source of the boost, always "premium"
+	Source string `json:"source"`
+
+	// User that boosted the chat
+	User User `json:"user"`
+}
+
+// The boost was obtained by the creation of Telegram Premium gift codes to boost a chat.
+// Each such code boosts the chat 4 times for the duration of the corresponding Telegram Premium subscription
+type ChatBoostSourceGiftCode struct {
+	// Source of the boost, always "gift_code"
+	Source string `json:"source"`
+
+	// User for which the gift code was created
+	User User `json:"user"`
+}
+
+// The boost was obtained by the creation of a Telegram Premium or a Telegram Star giveaway.
+// This boosts the chat 4 times for the duration of the corresponding Telegram Premium subscription
+// for Telegram Premium giveaways and prize_star_count / 500 times for one year for Telegram Star giveaways
+type ChatBoostSourceGiveaway struct {
+	// Source of the boost, always "giveaway"
+	Source string `json:"source"`
+
+	// Identifier of a message in the chat with the giveaway; the message could have been deleted already. May be 0 if the message isn't sent yet
+	GiveawayMessageID int64 `json:"giveaway_message_id"`
+
+	// [Optional] User that won the prize in the giveaway if any; for Telegram Premium giveaways only
+	User *User `json:"user,omitempty"`
+
+	// [Optional] The number of Telegram Stars to be split between giveaway winners; for Telegram Star giveaways only
+	PrizeStarCount int64 `json:"prize_star_count,omitempty"`
+
+	// [Optional] True if the giveaway was completed, but there was no user to win the prize
+	IsUnclaimed bool `json:"is_unclaimed,omitempty"`
+}
+
+// This struct contains information about a chat boost
+type ChatBoost struct {
+	// Unique identifier of the boost
+	BoostID string `json:"boost_id"`
+
+	// Point in time (Unix timestamp) when the chat was boosted
+	AddDate int64 `json:"add_date"`
+
+	// Point in time (Unix timestamp) when the boost will automatically expire, unless the booster's Telegram Premium subscription is prolonged
+	ExpirationDate int64 `json:"expiration_date"`
+
+	// Source of the added boost
+	Source ChatBoostSource `json:"source"`
+}
+
+// This struct represents a boost added to a chat or changed
+type ChatBoostUpdated struct {
+	// Chat which was boosted
+	Chat Chat `json:"chat"`
+
+	// Information about the chat boost
+	Boost ChatBoost `json:"boost"`
+}
+
+// This struct represents a boost removed from a chat
+type ChatBoostRemoved struct {
+	// Chat which was boosted
+	Chat Chat `json:"chat"`
+
+	// Unique identifier of the boost
+	BoostID string `json:"boost_id"`
+
+	// Point in time (Unix timestamp) when the boost was removed
+	RemoveDate int64 `json:"remove_date"`
+
+	// Source of the removed boost
+	Source ChatBoostSource `json:"source"`
+}
+
+// This struct represents a list of boosts added to a chat by a user (the result of getUserChatBoosts)
+type UserChatBoosts struct {
+	// The list of boosts added to the chat by the user
+	Boosts []ChatBoost `json:"boosts"`
+}
+
+// Parameters of the getUserChatBoosts method, which returns the list of boosts added to a chat by a user.
+// Requires administrator rights in the chat
+type GetUserChatBoostsParams struct {
+	// Unique identifier for the chat or username of the channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+// This struct contains information about a paid media purchase
+type PaidMediaPurchased struct {
+	// User who purchased the media
+	From User `json:"from"`
+
+	// Bot-specified paid media payload
+	PaidMediaPayload string `json:"paid_media_payload"`
+}
+
+// This struct represents an incoming update.
+// At most one of the optional fields can be present in any given update
+type Update struct {
+	// The update's unique identifier. Update identifiers start from a certain positive number and increase sequentially.
+	// If there are no new updates for at least a week, then identifier of the next update will be chosen randomly
+	UpdateID int64 `json:"update_id"`
+
+	// [Optional] New incoming message of any kind - text, photo, sticker, etc.
+	Message *Message `json:"message,omitempty"`
+
+	// [Optional] New version of a message that is known to the bot and was edited.
+	// This update may at times be triggered by changes to message fields that are either unavailable or not actively used by your bot
+	EditedMessage *Message `json:"edited_message,omitempty"`
+
+	// [Optional] New incoming channel post of any kind - text, photo, sticker, etc.
+	ChannelPost *Message `json:"channel_post,omitempty"`
+
+	// [Optional] New version of a channel post that is known to the bot and was edited.
+	// Same caveat as EditedMessage
+	EditedChannelPost *Message `json:"edited_channel_post,omitempty"`
+
+	// [Optional] New incoming inline query
+	InlineQuery *InlineQuery `json:"inline_query,omitempty"`
+
+	// [Optional] New incoming callback query
+	CallbackQuery *CallbackQuery `json:"callback_query,omitempty"`
+
+	// [Optional] A user purchased paid media with a non-empty payload sent by the bot in a non-channel chat
+	PurchasedPaidMedia *PaidMediaPurchased `json:"purchased_paid_media,omitempty"`
+
+	// [Optional] A chat boost was added or changed. The bot must be an administrator in the chat to receive these updates
+	ChatBoost *ChatBoostUpdated `json:"chat_boost,omitempty"`
+
+	// [Optional] A boost was removed from a chat. The bot must be an administrator in the chat to receive these updates
+	RemovedChatBoost *ChatBoostRemoved `json:"removed_chat_boost,omitempty"`
+
+	// [Optional] New incoming shipping query. Only for invoices with flexible price
+	ShippingQuery *ShippingQuery `json:"shipping_query,omitempty"`
+
+	// [Optional] The bot's chat member status was updated in a chat. For private chats,
+	// this update is received only when the bot is blocked or unblocked by the user
+	MyChatMember *ChatMemberUpdated `json:"my_chat_member,omitempty"`
+
+	// [Optional] A chat member's status was updated in a chat. The bot must be an administrator in the chat
+	// and must explicitly specify "chat_member" in the list of allowed_updates to receive these updates
+	ChatMember *ChatMemberUpdated `json:"chat_member,omitempty"`
+
+	// [Optional] New message from a connected business account
+	BusinessMessage *Message `json:"business_message,omitempty"`
+
+	// [Optional] New version of a message from a connected business account
+	EditedBusinessMessage *Message `json:"edited_business_message,omitempty"`
+
+	// The JSON the update was decoded from, filled in by UnmarshalJSON. Fields this library doesn't
+	// model yet are ignored when decoding, but they can still be read from here
+	Raw json.RawMessage `json:"-"`
+}
+
+// Identifies a message to edit, in one of two ways: ChatID and MessageID for a message in a chat,
+// or InlineMessageID for a message sent via the bot in inline mode. Build it with ByChat or ByInline.
+// It is embedded in the params of the edit methods, and like BaseSendParams its fields are sent as top-level fields
+type MessageLocator struct {
+	// [Optional] Required if InlineMessageID is not specified. Unique identifier for the target chat or username of the target channel
+	ChatID ChatID `json:"chat_id,omitzero"`
+
+	// [Optional] Required if InlineMessageID is not specified. Identifier of the message
+	MessageID int64 `json:"message_id,omitempty"`
+
+	// [Optional] Required if ChatID and MessageID are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// The parameters shared by all the send* methods. It is embedded in each Send*Params struct,
+// and encoding/json flattens embedded structs, so they are sent as top-level fields
+type BaseSendParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// [Optional] Unique identifier for the target message thread (topic) of a forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+
+	// [Optional] Unique identifier of the message effect to be added to the message; for private chats only
+	MessageEffectID string `json:"message_effect_id,omitempty"`
+
+	// [Optional] Sends the message silently. Users will receive a notification with no sound
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// [Optional] Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// [Optional] Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// [Optional] Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// [Optional] Additional interface options: an inline keyboard, a custom reply keyboard,
+	// instructions to remove a reply keyboard or to force a reply from the user. Not allowed by sendMediaGroup
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitzero"`
+}
+
+// Parameters of the sendMessage method.
+// Telegram calls them "parameters" and not "types", but they are just JSON objects too
+type SendMessageParams struct {
+	BaseSendParams
+
+	// Text of the message to be sent, 1-4096 characters after entities parsing
+	Text string `json:"text"`
+
+	// [Optional] Mode for parsing entities in the message text. See formatting options for more details
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in message text,
+	// which can be specified instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+}
+
+// InputMedia, another "union", for the content of a media group and for editMessageMedia:
+// - InputMediaPhoto
+// - InputMediaVideo
+// - InputMediaAudio
+// - InputMediaDocument
+// - InputMediaAnimation (only for editMessageMedia, it can't be part of an album)
+// Like MaybeInaccessibleMessage, it is a struct with one pointer per member: set exactly one
+type InputMedia struct {
+	Photo     *InputMediaPhoto
+	Video     *InputMediaVideo
+	Audio     *InputMediaAudio
+	Document  *InputMediaDocument
+	Animation *InputMediaAnimation
+}
+
+// This struct represents a photo to be sent
+type InputMediaPhoto struct {
+	// Type of the result, must be "photo". It is filled in automatically when sent as an InputMedia
+	Type string `json:"type"`
+
+	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended),
+	// pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>"
+	// to upload a new one using multipart/form-data under <file_attach_name> name
+	Media string `json:"media"`
+
+	// [Optional] Caption of the photo to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the photo caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] Pass True if the photo needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// This struct represents a video to be sent
+type InputMediaVideo struct {
+	// Type of the result, must be "video". It is filled in automatically when sent as an InputMedia
+	Type string `json:"type"`
+
+	// File to send, same rules as InputMediaPhoto.Media
+	Media string `json:"media"`
+
+	// [Optional] Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Thumbnails can't be reused and can be only uploaded as a new file ("attach://<file_attach_name>")
+	Thumbnail string `json:"thumbnail,omitempty"`
+
+	// [Optional] Caption of the video to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the video caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] Video width
+	Width int64 `json:"width,omitempty"`
+
+	// [Optional] Video height
+	Height int64 `json:"height,omitempty"`
+
+	// [Optional] Video duration in seconds
+	Duration int64 `json:"duration,omitempty"`
+
+	// [Optional] Pass True if the uploaded video is suitable for streaming
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+
+	// [Optional] Pass True if the video needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// This struct represents an audio file to be treated as music to be sent
+type InputMediaAudio struct {
+	// Type of the result, must be "audio". It is filled in automatically when sent as an InputMedia
+	Type string `json:"type"`
+
+	// File to send, same rules as InputMediaPhoto.Media
+	Media string `json:"media"`
+
+	// [Optional] Thumbnail of the file sent, same rules as InputMediaVideo.Thumbnail
+	Thumbnail string `json:"thumbnail,omitempty"`
+
+	// [Optional] Caption of the audio to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the audio caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Duration of the audio in seconds
+	Duration int64 `json:"duration,omitempty"`
+
+	// [Optional] Performer of the audio
+	Performer string `json:"performer,omitempty"`
+
+	// [Optional] Title of the audio
+	Title string `json:"title,omitempty"`
+}
+
+// This struct represents an animation file (GIF or H.264/MPEG-4 AVC video without sound) to be sent
+type InputMediaAnimation struct {
+	// Type of the result, must be "animation". It is filled in automatically when sent as an InputMedia
+	Type string `json:"type"`
+
+	// File to send, same rules as InputMediaPhoto.Media
+	Media string `json:"media"`
+
+	// [Optional] Thumbnail of the file sent, same rules as InputMediaVideo.Thumbnail
+	Thumbnail string `json:"thumbnail,omitempty"`
+
+	// [Optional] Caption of the animation to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the animation caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] Animation width
+	Width int64 `json:"width,omitempty"`
+
+	// [Optional] Animation height
+	Height int64 `json:"height,omitempty"`
+
+	// [Optional] Animation duration in seconds
+	Duration int64 `json:"duration,omitempty"`
+
+	// [Optional] Pass True if the animation needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// This struct represents a general file to be sent
+type InputMediaDocument struct {
+	// Type of the result, must be "document". It is filled in automatically when sent as an InputMedia
+	Type string `json:"type"`
+
+	// File to send, same rules as InputMediaPhoto.Media
+	Media string `json:"media"`
+
+	// [Optional] Thumbnail of the file sent, same rules as InputMediaVideo.Thumbnail
+	Thumbnail string `json:"thumbnail,omitempty"`
+
+	// [Optional] Caption of the document to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the document caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Disables automatic server-side content type detection for files uploaded using multipart/form-data.
+	// Always True, if the document is sent as part of an album
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+}
+
+// Parameters of the sendMediaGroup method
+type SendMediaGroupParams struct {
+	BaseSendParams
+
+	// An array describing messages to be sent, must include 2-10 items.
+	// Documents and audio files can be only grouped in an album with messages of the same type
+	Media []InputMedia `json:"media"`
+}
+
+// Parameters of the replaceStickerInSet method. It replaces an existing sticker in a sticker set with a new one.
+// The method is equivalent to calling deleteStickerFromSet, then addStickerToSet, then setStickerPositionInSet
+type ReplaceStickerInSetParams struct {
+	// User identifier of the sticker set owner
+	UserID int64 `json:"user_id"`
+
+	// Sticker set name
+	Name string `json:"name"`
+
+	// File identifier of the replaced sticker
+	OldSticker string `json:"old_sticker"`
+
+	// An object with information about the added sticker. If exactly the same sticker had already been added to the set,
+	// then the set remains unchanged
+	Sticker InputSticker `json:"sticker"`
+}
+
+// Parameters of the deleteStickerSet method, for sticker sets created by the bot. It returns True on success
+type DeleteStickerSetParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+}
+
+// Parameters of the sendPhoto method
+type SendPhotoParams struct {
+	BaseSendParams
+
+	// Photo to send. Pass a file_id to send a photo that exists on the Telegram servers (recommended)
+	// or pass an HTTP URL for Telegram to get a photo from the Internet.
+	// The photo must be at most 10 MB in size. The photo's width and height must not exceed 10000 in total.
+	// Width and height ratio must be at most 20
+	Photo string `json:"photo"`
+
+	// [Optional] Photo caption (may also be used when resending photos by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the photo caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] Pass True if the photo needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// Parameters of the sendVideo method
+type SendVideoParams struct {
+	BaseSendParams
+
+	// Video to send. Pass a file_id to send a video that exists on the Telegram servers (recommended)
+	// or pass an HTTP URL for Telegram to get a video from the Internet
+	Video string `json:"video"`
+
+	// [Optional] Duration of sent video in seconds. Same as SendVoiceParams.Duration, 0 means "let Telegram find out"
+	Duration int64 `json:"duration,omitempty"`
+
+	// [Optional] Video width
+	Width int64 `json:"width,omitempty"`
+
+	// [Optional] Video height
+	Height int64 `json:"height,omitempty"`
+
+	// [Optional] Video caption (may also be used when resending videos by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the video caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] Pass True if the video needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+
+	// [Optional] Pass True if the uploaded video is suitable for streaming
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+}
+
+// Parameters of the sendDocument method
+type SendDocumentParams struct {
+	BaseSendParams
+
+	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended)
+	// or pass an HTTP URL for Telegram to get a file from the Internet
+	Document string `json:"document"`
+
+	// [Optional] Document caption (may also be used when resending documents by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the document caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Disables automatic server-side content type detection for files uploaded using multipart/form-data
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+}
+
+// Parameters of the editMessageCaption method.
+// The message is identified by a MessageLocator
+type EditMessageCaptionParams struct {
+	// The message to edit, see ByChat and ByInline
+	MessageLocator
+
+	// [Optional] New caption of the message, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the message caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Pass True, if the caption must be shown above the message media. Supported only for animation, photo and video messages
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] A JSON-serialized object for an inline keyboard
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Parameters of the setMessageReaction method.
+// Bots can't use paid reactions
+type SetMessageReactionParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// Identifier of the target message. If the message belongs to a media group, the reaction is set to the first non-deleted message in the group instead
+	MessageID int64 `json:"message_id"`
+
+	// [Optional] A JSON-serialized list of reaction types to set on the message. Currently, as non-premium users, bots can set up to one reaction per message.
+	// A custom emoji reaction can be used if it is either already present on the message or explicitly allowed by chat administrators.
+	// Leave it empty to remove the reactions of the bot
+	Reaction []ReactionType `json:"reaction,omitempty"`
+
+	// [Optional] Pass True to set the reaction with a big animation
+	IsBig bool `json:"is_big,omitempty"`
+}
+
+// Parameters of the sendAudio method, for audio files the Telegram clients should display in the music player.
+// The audio must be in the .MP3 or .M4A format. Bots can currently send audio files of up to 50 MB in size
+type SendAudioParams struct {
+	BaseSendParams
+
+	// Audio file to send. Pass a file_id to send an audio file that exists on the Telegram servers (recommended),
+	// pass an HTTP URL for Telegram to get an audio file from the Internet, or "attach://<file_attach_name>" to upload a new one
+	Audio string `json:"audio"`
+
+	// [Optional] Audio caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the audio caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Duration of the audio in seconds. Same as SendVoiceParams.Duration, 0 means "let Telegram find out"
+	Duration int64 `json:"duration,omitempty"`
+
+	// [Optional] Performer
+	Performer string `json:"performer,omitempty"`
+
+	// [Optional] Track name
+	Title string `json:"title,omitempty"`
+
+	// [Optional] Thumbnail of the file sent, same rules as InputMediaVideo.Thumbnail: it must be uploaded
+	// as a new file ("attach://<file_attach_name>"), see ValidateThumbnail for the checks on its content
+	Thumbnail string `json:"thumbnail,omitempty"`
+}
+
+// Parameters of the sendVoice method.
+// The audio must be in an .OGG file encoded with OPUS, or in .MP3 format, or in .M4A format
+type SendVoiceParams struct {
+	BaseSendParams
+
+	// Audio file to send. Pass a file_id to send a file that exists on the Telegram servers (recommended)
+	// or pass an HTTP URL for Telegram to get a file from the Internet
+	Voice string `json:"voice"`
+
+	// [Optional] Voice message caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the voice message caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Duration of the voice message in seconds.
+	// Leave it 0 if you don't know it: the field is then omitted from the request and Telegram works it out
+	Duration int64 `json:"duration,omitempty"`
+}
+
+// Parameters of the sendVideoNote method. Video notes are the rounded square MPEG4 videos of up to 1 minute long
+type SendVideoNoteParams struct {
+	BaseSendParams
+
+	// Video note to send. Pass a file_id to send a video note that exists on the Telegram servers (recommended).
+	// Sending video notes by a URL is currently unsupported
+	VideoNote string `json:"video_note"`
+
+	// [Optional] Duration of sent video in seconds. Same as SendVoiceParams.Duration, 0 means "let Telegram find out"
+	Duration int64 `json:"duration,omitempty"`
+
+	// [Optional] Video width and height, i.e. diameter of the video message
+	Length int64 `json:"length,omitempty"`
+}
+
+// Parameters of the setStickerKeywords method.
+// The sticker must belong to a sticker set created by the bot and be of type "regular" or "custom_emoji"
+type SetStickerKeywordsParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+
+	// [Optional] A JSON-serialized list of 0-20 search keywords for the sticker with total length of up to 64 characters.
+	// Leave it empty to remove the keywords
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// Parameters of the setCustomEmojiStickerSetThumbnail method
+type SetCustomEmojiStickerSetThumbnailParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+
+	// [Optional] Custom emoji identifier of a sticker from the sticker set;
+	// leave it empty to drop the thumbnail and use the first sticker as the thumbnail
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// Parameters of the sendPoll method
+type SendPollParams struct {
+	BaseSendParams
+
+	// Poll question, 1-300 characters
+	Question string `json:"question"`
+
+	// A JSON-serialized list of 2-12 answer options. NewPollOptions builds them from plain strings
+	Options []InputPollOption `json:"options"`
+
+	// [Optional] True, if the poll needs to be anonymous, defaults to True.
+	// A pointer, because of that default: nil means "don't care", false must be sent explicitly
+	IsAnonymous *bool `json:"is_anonymous,omitempty"`
+
+	// [Optional] Poll type, "quiz" or "regular", defaults to "regular"
+	Type string `json:"type,omitempty"`
+
+	// [Optional] True, if the poll allows multiple answers, ignored for polls in quiz mode
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers,omitempty"`
+
+	// [Optional] 0-based identifier of the correct answer option, required for polls in quiz mode.
+	// A pointer, because 0 is a valid option
+	CorrectOptionID *int64 `json:"correct_option_id,omitempty"`
+
+	// [Optional] Text that is shown when a user chooses an incorrect answer or taps on the lamp icon in a quiz-style poll,
+	// 0-200 characters with at most 2 line feeds after entities parsing
+	Explanation string `json:"explanation,omitempty"`
+
+	// [Optional] Mode for parsing entities in the explanation
+	ExplanationParseMode ParseMode `json:"explanation_parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the poll explanation. It can be specified instead of explanation_parse_mode
+	ExplanationEntities []MessageEntity `json:"explanation_entities,omitempty"`
+
+	// [Optional] Amount of time in seconds the poll will be active after creation, 5-600. Can't be used together with close_date
+	OpenPeriod int64 `json:"open_period,omitempty"`
+
+	// [Optional] Point in time (Unix timestamp) when the poll will be automatically closed.
+	// Must be at least 5 and no more than 600 seconds in the future. Can't be used together with open_period
+	CloseDate int64 `json:"close_date,omitempty"`
+
+	// [Optional] Pass True if the poll needs to be immediately closed. This can be useful for poll preview
+	IsClosed bool `json:"is_closed,omitempty"`
+}
+
+// Parameters of the editUserStarSubscription method, which cancels or re-enables the extension
+// of a subscription paid in Telegram Stars. It returns True on success
+type EditUserStarSubscriptionParams struct {
+	// Identifier of the user whose subscription will be edited
+	UserID int64 `json:"user_id"`
+
+	// Telegram payment identifier for the subscription (SuccessfulPayment.TelegramPaymentChargeID)
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+
+	// Pass True to cancel extension of the user subscription; the subscription must be active up to the end of the current subscription period.
+	// Pass False to allow the user to re-enable a subscription that was previously canceled by the bot.
+	// Not omitempty: false is a meaningful value here
+	IsCanceled bool `json:"is_canceled"`
+}
+
+// Parameters of the sendChatAction method, which tells the user that something is happening on the bot's side.
+// The status is set for 5 seconds or less (when a message arrives from your bot, Telegram clients clear its typing status)
+type SendChatActionParams struct {
+	// Unique identifier for the target chat or username of the target supergroup. Channel chats and channel direct messages chats aren't supported
+	ChatID ChatID `json:"chat_id"`
+
+	// [Optional] Unique identifier for the target message thread; for supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+
+	// Type of action to broadcast. Choose one, depending on what the user is about to receive: "typing" for text messages,
+	// "upload_photo" for photos, "record_video" or "upload_video" for videos, "record_voice" or "upload_voice" for voice notes,
+	// "upload_document" for general files, "choose_sticker" for stickers, "find_location" for location data,
+	// "record_video_note" or "upload_video_note" for video notes
+	Action string `json:"action"`
+}
+
+// Parameters of the sendContact method
+type SendContactParams struct {
+	BaseSendParams
+
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+
+	// Contact's first name
+	FirstName string `json:"first_name"`
+
+	// [Optional] Contact's last name
+	LastName string `json:"last_name,omitempty"`
+
+	// [Optional] Additional data about the contact in the form of a vCard, 0-2048 bytes
+	VCard string `json:"vcard,omitempty"`
+}
+
+// Parameters of the setWebhook method.
+// Whenever there is an update for the bot, Telegram sends an HTTPS POST request to the URL, containing a JSON-serialized Update
+type SetWebhookParams struct {
+	// HTTPS URL to send updates to. Use an empty string to remove webhook integration
+	URL string `json:"url"`
+
+	// [Optional] The fixed IP address which will be used to send webhook requests instead of the IP address resolved through DNS
+	IPAddress string `json:"ip_address,omitempty"`
+
+	// [Optional] The maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery, 1-100. Defaults to 40
+	MaxConnections int64 `json:"max_connections,omitempty"`
+
+	// [Optional] A JSON-serialized list of the update types you want your bot to receive (see the UpdateType constants).
+	// Specify an empty list to receive all update types except chat_member, message_reaction, and message_reaction_count.
+	// If not specified (nil), the previous setting will be used, while an empty non-nil list is sent as is
+	AllowedUpdates []string `json:"allowed_updates,omitzero"`
+
+	// [Optional] Pass True to drop all pending updates
+	DropPendingUpdates bool `json:"drop_pending_updates,omitempty"`
+
+	// [Optional] A secret token to be sent in a header "X-Telegram-Bot-Api-Secret-Token" in every webhook request, 1-256 characters.
+	// Only characters A-Z, a-z, 0-9, _ and - are allowed
+	SecretToken string `json:"secret_token,omitempty"`
+
+	// [Optional] The public key certificate, for a self-signed one, so that the root certificate in use can be checked.
+	// If set, SetWebhook uploads it with multipart/form-data
+	Certificate *InputFile `json:"-"`
+}
+
+// This struct describes the current status of a webhook (the result of getWebhookInfo)
+type WebhookInfo struct {
+	// Webhook URL, may be empty if webhook is not set up
+	URL string `json:"url"`
+
+	// True, if a custom certificate was provided for webhook certificate checks
+	HasCustomCertificate bool `json:"has_custom_certificate"`
+
+	// Number of updates awaiting delivery
+	PendingUpdateCount int64 `json:"pending_update_count"`
+
+	// [Optional] Currently used webhook IP address
+	IPAddress string `json:"ip_address,omitempty"`
+
+	// [Optional] Unix time for the most recent error that happened when trying to deliver an update via webhook
+	LastErrorDate int64 `json:"last_error_date,omitempty"`
+
+	// [Optional] Error message in human-readable format for the most recent error that happened when trying to deliver an update via webhook
+	LastErrorMessage string `json:"last_error_message,omitempty"`
+
+	// [Optional] Unix time of the most recent error that happened when trying to synchronize available updates with Telegram datacenters
+	LastSynchronizationErrorDate int64 `json:"last_synchronization_error_date,omitempty"`
+
+	// [Optional] The maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery
+	MaxConnections int64 `json:"max_connections,omitempty"`
+
+	// [Optional] A list of update types the bot is subscribed to. Defaults to all update types except chat_member
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+// Parameters of the getUpdates method, used to receive incoming updates using long polling
+type GetUpdatesParams struct {
+	// [Optional] Identifier of the first update to be returned. Must be greater by one than the highest among the identifiers
+	// of previously received updates. By default, updates starting with the earliest unconfirmed update are returned.
+	// An update is considered confirmed as soon as getUpdates is called with an offset higher than its update_id
+	Offset int64 `json:"offset,omitempty"`
+
+	// [Optional] Limits the number of updates to be retrieved. Values between 1-100 are accepted. Defaults to 100
+	Limit int64 `json:"limit,omitempty"`
+
+	// [Optional] Timeout in seconds for long polling. Defaults to 0, i.e. usual short polling.
+	// Should be positive, short polling should be used for testing purposes only
+	Timeout int64 `json:"timeout,omitempty"`
+
+	// [Optional] A JSON-serialized list of the update types you want your bot to receive, same as SetWebhookParams.AllowedUpdates
+	AllowedUpdates []string `json:"allowed_updates,omitzero"`
+}
+
+// Parameters of the setChatStickerSet method. The bot must be an administrator in the chat with the appropriate rights.
+// Use ChatFullInfo.CanSetStickerSet to check if the bot can use this method. It returns True on success
+type SetChatStickerSetParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// Name of the sticker set to be set as the group sticker set
+	StickerSetName string `json:"sticker_set_name"`
+}
+
+// Parameters of the deleteChatStickerSet method. Same rules as setChatStickerSet
+type DeleteChatStickerSetParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Parameters of the answerCallbackQuery method, which sends an answer to a callback query sent from an inline keyboard.
+// The answer will be displayed to the user as a notification at the top of the chat screen or as an alert.
+// Until it is answered, the client shows a loading spinner on the button. It returns True on success
+type AnswerCallbackQueryParams struct {
+	// Unique identifier for the query to be answered
+	CallbackQueryID string `json:"callback_query_id"`
+
+	// [Optional] Text of the notification. If not specified, nothing will be shown to the user, 0-200 characters
+	Text string `json:"text,omitempty"`
+
+	// [Optional] If True, an alert will be shown by the client instead of a notification at the top of the chat screen. Defaults to false
+	ShowAlert bool `json:"show_alert,omitempty"`
+
+	// [Optional] URL that will be opened by the user's client. It can be a game URL (for a callback_game button)
+	// or a t.me link that opens the bot with a parameter
+	URL string `json:"url,omitempty"`
+
+	// [Optional] The maximum amount of time in seconds that the result of the callback query may be cached client-side. Defaults to 0
+	CacheTime int64 `json:"cache_time,omitempty"`
+}
+
+// Parameters of the editMessageText method.
+// The message is identified by a MessageLocator
+type EditMessageTextParams struct {
+	// The message to edit, see ByChat and ByInline
+	MessageLocator
+
+	// New text of the message, 1-4096 characters after entities parsing
+	Text string `json:"text"`
+
+	// [Optional] Mode for parsing entities in the message text
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in message text, which can be specified instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+
+	// [Optional] A JSON-serialized object for an inline keyboard
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// This struct describes an amount of Telegram Stars
+type StarAmount struct {
+	// Integer amount of Telegram Stars, rounded to 0; can be negative
+	Amount int64 `json:"amount"`
+
+	// [Optional] The number of 1/1000000000 shares of Telegram Stars; from -999999999 to 999999999;
+	// can be negative if and only if amount is non-positive
+	NanostarAmount int64 `json:"nanostar_amount,omitempty"`
+}
+
+// This struct contains the list of gifts received and owned by a user or a chat, one page at a time.
+// The gifts themselves (OwnedGiftRegular and OwnedGiftUnique) are not modeled yet: each one is left as JSON
+type OwnedGifts struct {
+	// The total number of gifts owned by the user or the chat
+	TotalCount int64 `json:"total_count"`
+
+	// The list of gifts
+	Gifts []json.RawMessage `json:"gifts"`
+
+	// [Optional] Offset for the next request. If empty, then there are no more results
+	NextOffset string `json:"next_offset,omitempty"`
+}
+
+// Parameters of the getBusinessAccountGifts method, which returns the gifts received and owned by a managed business account.
+// Requires the can_view_gifts_and_stars business bot right
+type GetBusinessAccountGiftsParams struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+
+	// [Optional] Pass True to exclude gifts that aren't saved to the account's profile page
+	ExcludeUnsaved bool `json:"exclude_unsaved,omitempty"`
+
+	// [Optional] Pass True to exclude gifts that are saved to the account's profile page
+	ExcludeSaved bool `json:"exclude_saved,omitempty"`
+
+	// [Optional] Pass True to exclude gifts that can be purchased an unlimited number of times
+	ExcludeUnlimited bool `json:"exclude_unlimited,omitempty"`
+
+	// [Optional] Pass True to exclude gifts that can be purchased a limited number of times
+	ExcludeLimited bool `json:"exclude_limited,omitempty"`
+
+	// [Optional] Pass True to exclude unique gifts
+	ExcludeUnique bool `json:"exclude_unique,omitempty"`
+
+	// [Optional] Pass True to sort results by gift price instead of send date. Sorting is applied before pagination
+	SortByPrice bool `json:"sort_by_price,omitempty"`
+
+	// [Optional] Offset of the first entry to return as received from the previous request; use empty string to get the first chunk of results
+	Offset string `json:"offset,omitempty"`
+
+	// [Optional] The maximum number of gifts to be returned; 1-100. Defaults to 100
+	Limit int64 `json:"limit,omitempty"`
+}
+
+// Parameters of the getBusinessAccountStarBalance method, which returns the StarAmount of a managed business account.
+// Requires the can_view_gifts_and_stars business bot right
+type GetBusinessAccountStarBalanceParams struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+}
+
+// Parameters of the transferBusinessAccountStars method, which transfers Telegram Stars from the business account balance to the bot's balance.
+// Requires the can_transfer_stars business bot right
+type TransferBusinessAccountStarsParams struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+
+	// Number of Telegram Stars to transfer; 1-10000
+	StarCount int64 `json:"star_count"`
+}
+
+// Parameters of the setBusinessAccountName method. Requires the can_change_name business bot right
+type SetBusinessAccountNameParams struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+
+	// The new value of the first name for the business account; 1-64 characters
+	FirstName string `json:"first_name"`
+
+	// [Optional] The new value of the last name for the business account; 0-64 characters
+	LastName string `json:"last_name,omitempty"`
+}
+
+// Parameters of the setBusinessAccountUsername method. Requires the can_change_username business bot right
+type SetBusinessAccountUsernameParams struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+
+	// [Optional] The new value of the username for the business account; 0-32 characters. Empty removes the username
+	Username string `json:"username,omitempty"`
+}
+
+// Parameters of the setBusinessAccountBio method. Requires the can_change_bio business bot right
+type SetBusinessAccountBioParams struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+
+	// [Optional] The new value of the bio for the business account; 0-140 characters. Empty removes the bio
+	Bio string `json:"bio,omitempty"`
+}
+
+// This struct represents the rights of an administrator in a chat
+type ChatAdministratorRights struct {
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+
+	// True, if the administrator can access the chat event log, get boost list, see hidden supergroup and channel members,
+	// report spam messages, ignore slow mode, and send messages to the chat without paying Telegram Stars.
+	// Implied by any other administrator privilege
+	CanManageChat bool `json:"can_manage_chat"`
+
+	// True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages"`
+
+	// True, if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+
+	// True, if the administrator can restrict, ban or unban chat members, or access supergroup statistics
+	CanRestrictMembers bool `json:"can_restrict_members"`
+
+	// True, if the administrator can add new administrators with a subset of their own privileges or demote administrators
+	// that they have promoted, directly or indirectly
+	CanPromoteMembers bool `json:"can_promote_members"`
+
+	// True, if the user is allowed to change the chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+
+	// True, if the administrator can post stories to the chat
+	CanPostStories bool `json:"can_post_stories"`
+
+	// True, if the administrator can edit stories posted by other users, post stories to the chat page, pin chat stories,
+	// and access the chat's story archive
+	CanEditStories bool `json:"can_edit_stories"`
+
+	// True, if the administrator can delete stories posted by other users
+	CanDeleteStories bool `json:"can_delete_stories"`
+
+	// [Optional] True, if the administrator can post messages in the channel, approve suggested posts, or access channel statistics; for channels only
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+
+	// [Optional] True, if the administrator can edit messages of other users and can pin messages; for channels only
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+
+	// [Optional] True, if the user is allowed to pin messages; for groups and supergroups only
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+
+	// [Optional] True, if the user is allowed to create, rename, close, and reopen forum topics; for supergroups only
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+
+	// [Optional] True, if the administrator can manage direct messages of the channel and decline suggested posts; for channels only
+	CanManageDirectMessages bool `json:"can_manage_direct_messages,omitempty"`
+}
+
+// Parameters of the editMessageReplyMarkup method, used to edit only the inline keyboard of a message.
+// The message is identified by a MessageLocator
+type EditMessageReplyMarkupParams struct {
+	// The message to edit, see ByChat and ByInline
+	MessageLocator
+
+	// [Optional] A JSON-serialized object for an inline keyboard. Nil removes the keyboard here,
+	// since Telegram treats a missing reply_markup as an empty one; use RemoveInlineKeyboard to make it explicit
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Parameters of the answerShippingQuery method, the reply to a ShippingQuery.
+// If Ok is true ShippingOptions must be set, otherwise ErrorMessage
+type AnswerShippingQueryParams struct {
+	// Unique identifier for the query to be answered
+	ShippingQueryID string `json:"shipping_query_id"`
+
+	// Pass True if delivery to the specified address is possible and False if there are any problems
+	Ok bool `json:"ok"`
+
+	// [Optional] Required if Ok is True. A JSON-serialized array of available shipping options
+	ShippingOptions []ShippingOption `json:"shipping_options,omitempty"`
+
+	// [Optional] Required if Ok is False. Error message in human readable form that explains why it is impossible to complete the order
+	// (e.g. "Sorry, delivery to your desired address is unavailable"). Telegram will display this message to the user
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// This struct describes actions that a non-administrator user is allowed to take in a chat
+type ChatPermissions struct {
+	// [Optional] True, if the user is allowed to send text messages, contacts, giveaways, giveaway winners, invoices, locations and venues
+	CanSendMessages bool `json:"can_send_messages,omitempty"`
+
+	// [Optional] True, if the user is allowed to send audios
+	CanSendAudios bool `json:"can_send_audios,omitempty"`
+
+	// [Optional] True, if the user is allowed to send documents
+	CanSendDocuments bool `json:"can_send_documents,omitempty"`
+
+	// [Optional] True, if the user is allowed to send photos
+	CanSendPhotos bool `json:"can_send_photos,omitempty"`
+
+	// [Optional] True, if the user is allowed to send videos
+	CanSendVideos bool `json:"can_send_videos,omitempty"`
+
+	// [Optional] True, if the user is allowed to send video notes
+	CanSendVideoNotes bool `json:"can_send_video_notes,omitempty"`
+
+	// [Optional] True, if the user is allowed to send voice notes
+	CanSendVoiceNotes bool `json:"can_send_voice_notes,omitempty"`
+
+	// [Optional] True, if the user is allowed to send polls and checklists
+	CanSendPolls bool `json:"can_send_polls,omitempty"`
+
+	// [Optional] True, if the user is allowed to send animations, games, stickers and use inline bots
+	CanSendOtherMessages bool `json:"can_send_other_messages,omitempty"`
+
+	// [Optional] True, if the user is allowed to add web page previews to their messages
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+
+	// [Optional] True, if the user is allowed to change the chat title, photo and other settings. Ignored in public supergroups
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+
+	// [Optional] True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+
+	// [Optional] True, if the user is allowed to pin messages. Ignored in public supergroups
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+
+	// [Optional] True, if the user is allowed to create forum topics. If omitted defaults to the value of CanPinMessages
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+}
+
+// This struct represents an invite link for a chat
+type ChatInviteLink struct {
+	// The invite link. If the link was created by another chat administrator, then the second part of the link will be replaced with "…"
+	InviteLink string `json:"invite_link"`
+
+	// Creator of the link
+	Creator User `json:"creator"`
+
+	// True, if users joining the chat via the link need to be approved by chat administrators
+	CreatesJoinRequest bool `json:"creates_join_request"`
+
+	// True, if the link is primary
+	IsPrimary bool `json:"is_primary"`
+
+	// True, if the link is revoked
+	IsRevoked bool `json:"is_revoked"`
+
+	// [Optional] Invite link name
+	Name string `json:"name,omitempty"`
+
+	// [Optional] Point in time (Unix timestamp) when the link will expire or has been expired
+	ExpireDate int64 `json:"expire_date,omitempty"`
+
+	// [Optional] The maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	MemberLimit int64 `json:"member_limit,omitempty"`
+
+	// [Optional] Number of pending join requests created using this link
+	PendingJoinRequestCount int64 `json:"pending_join_request_count,omitempty"`
+
+	// [Optional] The number of seconds the subscription will be active for before the next payment
+	SubscriptionPeriod int64 `json:"subscription_period,omitempty"`
+
+	// [Optional] The amount of Telegram Stars a user must pay initially and after each subsequent subscription period to be a member of the chat using the link
+	SubscriptionPrice int64 `json:"subscription_price,omitempty"`
+}
+
+// ChatMember, another "union" with a discriminator, the field "status"
+// - ChatMemberOwner ("creator")
+// - ChatMemberAdministrator ("administrator")
+// - ChatMemberMember ("member")
+// - ChatMemberRestricted ("restricted")
+// - ChatMemberLeft ("left")
+// - ChatMemberBanned ("kicked")
+type ChatMember struct {
+	Owner         *ChatMemberOwner
+	Administrator *ChatMemberAdministrator
+	Member        *ChatMemberMember
+	Restricted    *ChatMemberRestricted
+	Left          *ChatMemberLeft
+	Banned        *ChatMemberBanned
+
+	// The JSON of a kind this library doesn't know yet, when none of the members above is set
+	Unknown json.RawMessage
+}
+
+// A chat member that owns the chat and has all administrator privileges
+type ChatMemberOwner struct {
+	// The member's status in the chat, always "creator"
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+
+	// [Optional] Custom title for this user
+	CustomTitle string `json:"custom_title,omitempty"`
+}
+
+// A chat member that has some additional privileges
+type ChatMemberAdministrator struct {
+	// The member's status in the chat, always "administrator"
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// True, if the bot is allowed to edit administrator privileges of that user
+	CanBeEdited bool `json:"can_be_edited"`
+
+	// The privileges of the administrator, the fields are flattened like in the Bot API
+	ChatAdministratorRights
+
+	// [Optional] Custom title for this user
+	CustomTitle string `json:"custom_title,omitempty"`
+}
+
+// A chat member that has no additional privileges or restrictions
+type ChatMemberMember struct {
+	// The member's status in the chat, always "member"
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// [Optional] Date when the user's subscription will expire; Unix time
+	UntilDate int64 `json:"until_date,omitempty"`
+}
+
+// A chat member that is under certain restrictions in the chat. Supergroups only
+type ChatMemberRestricted struct {
+	// The member's status in the chat, always "restricted"
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// True, if the user is a member of the chat at the moment of the request
+	IsMember bool `json:"is_member"`
+
+	// What the user can still do, the fields are flattened like in the Bot API
+	ChatPermissions
+
+	// Date when restrictions will be lifted for this user; Unix time. If 0, then the user is restricted forever
+	UntilDate int64 `json:"until_date"`
+}
+
+// A chat member that isn't currently a member of the chat, but may join it themselves
+type ChatMemberLeft struct {
+	// The member's status in the chat, always "left"
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+}
+
+// A chat member that was banned in the chat and can't return to the chat or view chat messages
+type ChatMemberBanned struct {
+	// The member's status in the chat, always "kicked"
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// Date when restrictions will be lifted for this user; Unix time. If 0, then the user is banned forever
+	UntilDate int64 `json:"until_date"`
+}
+
+// This struct represents changes in the status of a chat member
+type ChatMemberUpdated struct {
+	// Chat the user belongs to
+	Chat Chat `json:"chat"`
+
+	// Performer of the action, which resulted in the change
+	From User `json:"from"`
+
+	// Date the change was done in Unix time
+	Date int64 `json:"date"`
+
+	// Previous information about the chat member
+	OldChatMember ChatMember `json:"old_chat_member"`
+
+	// New information about the chat member
+	NewChatMember ChatMember `json:"new_chat_member"`
+
+	// [Optional] Chat invite link, which was used by the user to join the chat; for joining by invite link events only
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+
+	// [Optional] True, if the user joined the chat after sending a direct join request without using an invite link
+	// and being approved by an administrator
+	ViaJoinRequest bool `json:"via_join_request,omitempty"`
+
+	// [Optional] True, if the user joined the chat via a chat folder invite link
+	ViaChatFolderInviteLink bool `json:"via_chat_folder_invite_link,omitempty"`
+}
+
+// This struct represents a service message about the creation of a scheduled giveaway
+type GiveawayCreated struct {
+	// [Optional] The number of Telegram Stars to be split between giveaway winners; for Telegram Star giveaways only
+	PrizeStarCount int64 `json:"prize_star_count,omitempty"`
+}
+
+// This struct represents a message about a scheduled giveaway.
+// A giveaway has either PrizeStarCount (Telegram Stars) or PremiumSubscriptionMonthCount (Telegram Premium) set
+type Giveaway struct {
+	// The list of chats which the user must join to participate in the giveaway
+	Chats []Chat `json:"chats"`
+
+	// Point in time (Unix timestamp) when winners of the giveaway will be selected
+	WinnersSelectionDate int64 `json:"winners_selection_date"`
+
+	// The number of users which are supposed to be selected as winners of the giveaway
+	WinnerCount int64 `json:"winner_count"`
+
+	// [Optional] True, if only users who join the chats after the giveaway started should be eligible to win
+	OnlyNewMembers bool `json:"only_new_members,omitempty"`
+
+	// [Optional] True, if the list of giveaway winners will be visible to everyone
+	HasPublicWinners bool `json:"has_public_winners,omitempty"`
+
+	// [Optional] Description of additional giveaway prize
+	PrizeDescription string `json:"prize_description,omitempty"`
+
+	// [Optional] A list of two-letter ISO 3166-1 alpha-2 country codes indicating the countries from which eligible users for the giveaway must come.
+	// If empty, then all users can participate in the giveaway.
+	// Users with a phone number that was bought on Fragment can always participate in giveaways
+	CountryCodes []string `json:"country_codes,omitempty"`
+
+	// [Optional] The number of Telegram Stars to be split between giveaway winners; for Telegram Star giveaways only
+	PrizeStarCount int64 `json:"prize_star_count,omitempty"`
+
+	// [Optional] The number of months the Telegram Premium subscription won from the giveaway will be active for; for Telegram Premium giveaways only
+	PremiumSubscriptionMonthCount int64 `json:"premium_subscription_month_count,omitempty"`
+}
+
+// This struct represents a message about the completion of a giveaway with public winners
+type GiveawayWinners struct {
+	// The chat that created the giveaway
+	Chat Chat `json:"chat"`
+
+	// Identifier of the message with the giveaway in the chat
+	GiveawayMessageID int64 `json:"giveaway_message_id"`
+
+	// Point in time (Unix timestamp) when winners of the giveaway were selected
+	WinnersSelectionDate int64 `json:"winners_selection_date"`
+
+	// Total number of winners in the giveaway
+	WinnerCount int64 `json:"winner_count"`
+
+	// List of up to 100 winners of the giveaway
+	Winners []User `json:"winners"`
+
+	// [Optional] The number of other chats the user had to join in order to be eligible for the giveaway
+	AdditionalChatCount int64 `json:"additional_chat_count,omitempty"`
+
+	// [Optional] The number of Telegram Stars that were split between giveaway winners; for Telegram Star giveaways only
+	PrizeStarCount int64 `json:"prize_star_count,omitempty"`
+
+	// [Optional] The number of months the Telegram Premium subscription won from the giveaway will be active for; for Telegram Premium giveaways only
+	PremiumSubscriptionMonthCount int64 `json:"premium_subscription_month_count,omitempty"`
+
+	// [Optional] Number of undistributed prizes
+	UnclaimedPrizeCount int64 `json:"unclaimed_prize_count,omitempty"`
+
+	// [Optional] True, if only users who had joined the chats after the giveaway started were eligible to win
+	OnlyNewMembers bool `json:"only_new_members,omitempty"`
+
+	// [Optional] True, if the giveaway was canceled because the payment for it was refunded
+	WasRefunded bool `json:"was_refunded,omitempty"`
+
+	// [Optional] Description of additional giveaway prize
+	PrizeDescription string `json:"prize_description,omitempty"`
+}
+
+// This struct represents a service message about the completion of a giveaway without public winners
+type GiveawayCompleted struct {
+	// Number of winners in the giveaway
+	WinnerCount int64 `json:"winner_count"`
+
+	// [Optional] Number of undistributed prizes
+	UnclaimedPrizeCount int64 `json:"unclaimed_prize_count,omitempty"`
+
+	// [Optional] Message with the giveaway that was completed, if it wasn't deleted
+	GiveawayMessage *Message `json:"giveaway_message,omitempty"`
+
+	// [Optional] True, if the giveaway is a Telegram Star giveaway. Otherwise, currently, the giveaway is a Telegram Premium giveaway
+	IsStarGiveaway bool `json:"is_star_giveaway,omitempty"`
+}
+
+// Parameters of the getChat method, which returns up-to-date information about the chat as a ChatFullInfo
+type GetChatParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Parameters of the getChatMember method, which returns information about a member of a chat as a ChatMember
+type GetChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+// Parameters of the setChatTitle method, which changes the title of a chat (not of a private one).
+// The bot must be an administrator with the can_change_info right
+type SetChatTitleParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// New chat title, 1-128 characters
+	Title string `json:"title"`
+}
+
+// Parameters of the setChatDescription method, which changes the description of a group,
+// a supergroup or a channel. The bot must be an administrator with the can_change_info right
+type SetChatDescriptionParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// [Optional] New chat description, 0-255 characters. Empty removes the description
+	Description string `json:"description,omitempty"`
+}
+
+// Parameters of the getChatAdministrators method, which returns the administrators of a chat
+// (as a list of ChatMember, owner included) that aren't bots
+type GetChatAdministratorsParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Parameters of the getChatMemberCount method, which returns the number of members in a chat as an Integer
+type GetChatMemberCountParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Parameters of the createForumTopic method, which creates a topic in a forum supergroup chat.
+// The bot must be an administrator in the chat with the can_manage_topics right. It returns a ForumTopic
+type CreateForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// Topic name, 1-128 characters
+	Name string `json:"name"`
+
+	// [Optional] Color of the topic icon in RGB format, one of the ForumIconColor constants
+	IconColor ForumIconColor `json:"icon_color,omitempty"`
+
+	// [Optional] Unique identifier of the custom emoji shown as the topic icon.
+	// It must be one of the stickers returned by getForumTopicIconStickers
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// This struct represents a forum topic
+type ForumTopic struct {
+	// Unique identifier of the forum topic
+	MessageThreadID int64 `json:"message_thread_id"`
+
+	// Name of the topic
+	Name string `json:"name"`
+
+	// Color of the topic icon in RGB format
+	IconColor ForumIconColor `json:"icon_color"`
+
+	// [Optional] Unique identifier of the custom emoji shown as the topic icon
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+
+	// [Optional] True, if the name of the topic wasn't specified explicitly by its creator and likely needs to be changed by the bot
+	IsNameImplicit bool `json:"is_name_implicit,omitempty"`
+}
+
+// Parameters of the getFile method, which returns the File with the file_path to download it.
+// The link is valid for at least 1 hour, then a new one can be requested by calling getFile again
+type GetFileParams struct {
+	// File identifier to get information about
+	FileID string `json:"file_id"`
+}
+
+// Parameters of the sendAnimation method, for animation files (GIF or H.264/MPEG-4 AVC video without sound).
+// Bots can currently send animation files of up to 50 MB in size
+type SendAnimationParams struct {
+	BaseSendParams
+
+	// Animation to send. Pass a file_id to send an animation that exists on the Telegram servers (recommended),
+	// pass an HTTP URL for Telegram to get an animation from the Internet, or "attach://<file_attach_name>" to upload a new one
+	Animation string `json:"animation"`
+
+	// [Optional] Duration of sent animation in seconds
+	Duration int64 `json:"duration,omitempty"`
+
+	// [Optional] Animation width
+	Width int64 `json:"width,omitempty"`
+
+	// [Optional] Animation height
+	Height int64 `json:"height,omitempty"`
+
+	// [Optional] Thumbnail of the file sent, same rules as SendAudioParams.Thumbnail
+	Thumbnail string `json:"thumbnail,omitempty"`
+
+	// [Optional] Animation caption (may also be used when resending animation by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the animation caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] Pass True if the animation needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// Parameters of the copyMessage method, which sends a copy of a message without a link to the original.
+// Service messages, paid media messages, giveaway messages, giveaway winners messages, and invoice messages can't be copied.
+// It returns the MessageID of the sent message
+type CopyMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// [Optional] Unique identifier for the target message thread (topic) of a forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+
+	// Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
+	FromChatID ChatID `json:"from_chat_id"`
+
+	// Message identifier in the chat specified in FromChatID
+	MessageID int64 `json:"message_id"`
+
+	// [Optional] New caption for media, 0-1024 characters after entities parsing. If not specified, the original caption is kept
+	Caption string `json:"caption,omitempty"`
+
+	// [Optional] Mode for parsing entities in the new caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] A JSON-serialized list of special entities that appear in the new caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// [Optional] Pass True, if the caption must be shown above the message media. Ignored if a new caption isn't specified
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// [Optional] Sends the message silently. Users will receive a notification with no sound
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// [Optional] Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// [Optional] Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// [Optional] Additional interface options: an inline keyboard, a custom reply keyboard,
+	// instructions to remove a reply keyboard or to force a reply from the user
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitzero"`
+}
+
+// Parameters of the editMessageMedia method, which replaces the animation, audio, document, photo, or video of a message.
+// The message is identified by a MessageLocator. A new file can't be uploaded for inline messages
+type EditMessageMediaParams struct {
+	// The message to edit, see ByChat and ByInline
+	MessageLocator
+
+	// A JSON-serialized object for a new media content of the message
+	Media InputMedia `json:"media"`
+
+	// [Optional] A JSON-serialized object for a new inline keyboard
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// InlineQueryResult, a "union" for the results of an inline query. Only articles are supported for now:
+// - InlineQueryResultArticle
+// Like InputMedia, it is a struct with one pointer per member: set exactly one
+type InlineQueryResult struct {
+	Article *InlineQueryResultArticle
+}
+
+// This struct represents a link to an article or web page
+type InlineQueryResultArticle struct {
+	// Type of the result, must be "article". It is filled in automatically when sent as an InlineQueryResult
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// Title of the result
+	Title string `json:"title"`
+
+	// Content of the message to be sent
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+
+	// [Optional] Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// [Optional] URL of the result
+	URL string `json:"url,omitempty"`
+
+	// [Optional] Short description of the result
+	Description string `json:"description,omitempty"`
+
+	// [Optional] Url of the thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+
+	// [Optional] Thumbnail width
+	ThumbnailWidth int64 `json:"thumbnail_width,omitempty"`
+
+	// [Optional] Thumbnail height
+	ThumbnailHeight int64 `json:"thumbnail_height,omitempty"`
+}
+
+// InputMessageContent, a "union" for the content of a message sent as the result of an inline query.
+// Only text messages are supported for now:
+// - InputTextMessageContent
+type InputMessageContent struct {
+	Text *InputTextMessageContent
+}
+
+// This struct represents the content of a text message to be sent as the result of an inline query
+type InputTextMessageContent struct {
+	// Text of the message to be sent, 1-4096 characters
+	MessageText string `json:"message_text"`
+
+	// [Optional] Mode for parsing entities in the message text. See formatting options for more details
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// [Optional] List of special entities that appear in message text, which can be specified instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+}
+
+// Parameters of the answerInlineQuery method, which sends the answers to an inline query.
+// No more than 50 results per query are allowed
+type AnswerInlineQueryParams struct {
+	// Unique identifier for the answered query
+	InlineQueryID string `json:"inline_query_id"`
+
+	// A JSON-serialized array of results for the inline query
+	Results []InlineQueryResult `json:"results"`
+
+	// [Optional] The maximum amount of time in seconds that the result of the inline query may be cached on the server,
+	// defaults to 300. A pointer, because of that default: nil means 300, 0 must be sent explicitly
+	CacheTime *int64 `json:"cache_time,omitempty"`
+
+	// [Optional] Pass True if results may be cached on the server side only for the user that sent the query.
+	// By default, results may be returned to any user who sends the same query
+	IsPersonal bool `json:"is_personal,omitempty"`
+
+	// [Optional] Pass the offset that a client should send in the next query with the same text to receive more results.
+	// Pass an empty string if there are no more results or if you don't support pagination. Offset length can't exceed 64 bytes
+	NextOffset string `json:"next_offset,omitempty"`
+
+	// [Optional] A JSON-serialized object describing a button to be shown above inline query results.
+	// It replaces the old switch_pm_text and switch_pm_parameter, see SwitchPMButton
+	Button *InlineQueryResultsButton `json:"button,omitempty"`
+}
+
+// This struct represents a button to be shown above inline query results. You must use exactly one of the optional fields
+type InlineQueryResultsButton struct {
+	// Label text on the button
+	Text string `json:"text"`
+
+	// [Optional] Description of the Web App that will be launched when the user presses the button.
+	// The Web App will be able to switch back to the inline mode using the method switchInlineQuery inside the Web App
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+
+	// [Optional] Deep-linking parameter for the /start message sent to the bot when a user presses the button.
+	// 1-64 characters, only A-Z, a-z, 0-9, _ and - are allowed
+	StartParameter string `json:"start_parameter,omitempty"`
+}
+
+// This struct describes a Web App
+type WebAppInfo struct {
+	// An HTTPS URL of a Web App to be opened with additional data as specified in Initializing Web Apps
+	URL string `json:"url"`
+}
+
+// This struct represents a bot command
+type BotCommand struct {
+	// Text of the command; 1-32 characters. Can contain only lowercase English letters, digits and underscores
+	Command string `json:"command"`
+
+	// Description of the command; 1-256 characters
+	Description string `json:"description"`
+}
+
+// BotCommandScope, a "union" for the scope to which bot commands are applied:
+// - BotCommandScopeDefault
+// - BotCommandScopeAllPrivateChats
+// - BotCommandScopeAllGroupChats
+// - BotCommandScopeAllChatAdministrators
+// - BotCommandScopeChat
+// - BotCommandScopeChatAdministrators
+// - BotCommandScopeChatMember
+// Like InputMedia, it is a struct with one pointer per member: set exactly one, or none for the default scope
+type BotCommandScope struct {
+	Default               *BotCommandScopeDefault
+	AllPrivateChats       *BotCommandScopeAllPrivateChats
+	AllGroupChats         *BotCommandScopeAllGroupChats
+	AllChatAdministrators *BotCommandScopeAllChatAdministrators
+	Chat                  *BotCommandScopeChat
+	ChatAdministrators    *BotCommandScopeChatAdministrators
+	ChatMember            *BotCommandScopeChatMember
+}
+
+// This struct represents the default scope of bot commands.
+// Default commands are used if no commands with a narrower scope are specified for the user
+type BotCommandScopeDefault struct {
+	// Scope type, must be "default". It is filled in automatically when sent as a BotCommandScope
+	Type string `json:"type"`
+}
+
+// This struct represents the scope of bot commands, covering all private chats
+type BotCommandScopeAllPrivateChats struct {
+	// Scope type, must be "all_private_chats". It is filled in automatically when sent as a BotCommandScope
+	Type string `json:"type"`
+}
+
+// This struct represents the scope of bot commands, covering all group and supergroup chats
+type BotCommandScopeAllGroupChats struct {
+	// Scope type, must be "all_group_chats". It is filled in automatically when sent as a BotCommandScope
+	Type string `json:"type"`
+}
+
+// This struct represents the scope of bot commands, covering all group and supergroup chat administrators
+type BotCommandScopeAllChatAdministrators struct {
+	// Scope type, must be "all_chat_administrators". It is filled in automatically when sent as a BotCommandScope
+	Type string `json:"type"`
+}
+
+// This struct represents the scope of bot commands, covering a specific chat
+type BotCommandScopeChat struct {
+	// Scope type, must be "chat". It is filled in automatically when sent as a BotCommandScope
+	Type string `json:"type"`
+
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatID ChatID `json:"chat_id"`
+}
+
+// This struct represents the scope of bot commands, covering all administrators of a specific group or supergroup chat
+type BotCommandScopeChatAdministrators struct {
+	// Scope type, must be "chat_administrators". It is filled in automatically when sent as a BotCommandScope
+	Type string `json:"type"`
+
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatID ChatID `json:"chat_id"`
+}
+
+// This struct represents the scope of bot commands, covering a specific member of a group or supergroup chat
+type BotCommandScopeChatMember struct {
+	// Scope type, must be "chat_member". It is filled in automatically when sent as a BotCommandScope
+	Type string `json:"type"`
+
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+// Parameters of the setMyCommands method, which changes the list of the bot's commands
+type SetMyCommandsParams struct {
+	// A JSON-serialized list of bot commands to be set as the list of the bot's commands. At most 100 commands can be specified
+	Commands []BotCommand `json:"commands"`
+
+	// [Optional] A JSON-serialized object, describing scope of users for which the commands are relevant.
+	// Defaults to BotCommandScopeDefault
+	Scope *BotCommandScope `json:"scope,omitempty"`
+
+	// [Optional] A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope,
+	// for whose language there are no dedicated commands
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Parameters of the getMyCommands method, which returns the current list of the bot's commands
+// for the given scope and user language
+type GetMyCommandsParams struct {
+	// [Optional] A JSON-serialized object, describing scope of users. Defaults to BotCommandScopeDefault
+	Scope *BotCommandScope `json:"scope,omitempty"`
+
+	// [Optional] A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Parameters of the restrictChatMember method, which restricts a user in a supergroup.
+// The bot must be an administrator with the can_restrict_members right. Pass all permissions true to lift restrictions
+type RestrictChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+
+	// A JSON-serialized object for new user permissions
+	Permissions ChatPermissions `json:"permissions"`
+
+	// [Optional] Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages
+	// and can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios, can_send_documents,
+	// can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes permissions;
+	// the can_send_polls permission will imply the can_send_messages permission
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+
+	// [Optional] Date when restrictions will be lifted for the user; Unix time. If user is restricted for more than 366 days
+	// or less than 30 seconds from the current time, they are considered to be restricted forever. See BanFor, BanUntil and BanForever
+	UntilDate int64 `json:"until_date,omitempty"`
+}
+
+// Parameters of the deleteMessages method, which deletes multiple messages simultaneously.
+// If some of the specified messages can't be found, they are skipped
+type DeleteMessagesParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// A JSON-serialized list of 1-100 identifiers of messages to delete. See deleteMessage for limitations on which messages can be deleted
+	MessageIDs []int64 `json:"message_ids"`
+}
+
+// Parameters of the banChatMember method, which bans a user in a group, a supergroup or a channel.
+// In the case of supergroups and channels, the user will not be able to return to the chat on their own
+// using invite links, etc., unless unbanned first. The bot must be an administrator with the can_restrict_members right
+type BanChatMemberParams struct {
+	// Unique target group or username of the target supergroup or channel (in the format @channelusername)
+	ChatID ChatID `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+
+	// [Optional] Date when the user will be unbanned; Unix time. If user is banned for more than 366 days
+	// or less than 30 seconds from the current time they are considered to be banned forever.
+	// Applied for supergroups and channels only. See BanFor, BanUntil and BanForever
+	UntilDate int64 `json:"until_date,omitempty"`
+
+	// [Optional] Pass True to delete all messages from the chat for the user that is being removed.
+	// If False, the user will be able to see messages in the group that were sent before the user was removed.
+	// Always True for supergroups and channels
+	RevokeMessages bool `json:"revoke_messages,omitempty"`
+}
+
+// Parameters of the getCustomEmojiStickers method, which returns information about custom emoji stickers by their identifiers
+type GetCustomEmojiStickersParams struct {
+	// A JSON-serialized list of custom emoji identifiers. At most 200 custom emoji identifiers can be specified
+	CustomEmojiIDs []string `json:"custom_emoji_ids"`
 }
@@ -0,0 +1,439 @@
+/* unions.go : JSON encoding of the "union" types
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Telegram has several types that can be one among a few structs.
+ * Go has no unions, so each of them is a struct with one pointer per
+ * possible member, and it decodes itself by looking at the JSON first.
+ */
+
+package telegram
+
+import "encoding/json"
+
+// UnmarshalJSON decodes a Message or an InaccessibleMessage, depending on the date field
+func (m *MaybeInaccessibleMessage) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Date int64 `json:"date"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	*m = MaybeInaccessibleMessage{}
+	if probe.Date == 0 {
+		m.InaccessibleMessage = new(InaccessibleMessage)
+		return json.Unmarshal(data, m.InaccessibleMessage)
+	}
+	m.Message = new(Message)
+	return json.Unmarshal(data, m.Message)
+}
+
+// MarshalJSON encodes whichever member of the union is set
+func (m MaybeInaccessibleMessage) MarshalJSON() ([]byte, error) {
+	if m.Message != nil {
+		return json.Marshal(m.Message)
+	}
+	if m.InaccessibleMessage != nil {
+		return json.Marshal(m.InaccessibleMessage)
+	}
+	return []byte("null"), nil
+}
+
+// IsAccessible reports whether the message can still be used by the bot
+func (m *MaybeInaccessibleMessage) IsAccessible() bool {
+	return m.Message != nil
+}
+
+// Chat returns the chat the message belongs to, whether it is accessible or not
+func (m *MaybeInaccessibleMessage) Chat() Chat {
+	switch {
+	case m.Message != nil:
+		return m.Message.Chat
+	case m.InaccessibleMessage != nil:
+		return m.InaccessibleMessage.Chat
+	}
+	return Chat{}
+}
+
+// MessageID returns the identifier of the message, whether it is accessible or not
+func (m *MaybeInaccessibleMessage) MessageID() int64 {
+	switch {
+	case m.Message != nil:
+		return m.Message.MessageID
+	case m.InaccessibleMessage != nil:
+		return m.InaccessibleMessage.MessageID
+	}
+	return 0
+}
+
+// MarshalJSON encodes whichever member of the union is set, filling in its type field
+func (m InputMedia) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Photo != nil:
+		photo := *m.Photo
+		photo.Type = "photo"
+		return json.Marshal(photo)
+	case m.Video != nil:
+		video := *m.Video
+		video.Type = "video"
+		return json.Marshal(video)
+	case m.Audio != nil:
+		audio := *m.Audio
+		audio.Type = "audio"
+		return json.Marshal(audio)
+	case m.Document != nil:
+		document := *m.Document
+		document.Type = "document"
+		return json.Marshal(document)
+	case m.Animation != nil:
+		animation := *m.Animation
+		animation.Type = "animation"
+		return json.Marshal(animation)
+	}
+	return []byte("null"), nil
+}
+
+// MarshalJSON encodes whichever member of the union is set, filling in the fields that are always true
+func (m ReplyMarkup) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Inline != nil:
+		return json.Marshal(m.Inline)
+	case m.Keyboard != nil:
+		return json.Marshal(m.Keyboard)
+	case m.Remove != nil:
+		remove := *m.Remove
+		remove.RemoveKeyboard = true
+		return json.Marshal(remove)
+	case m.ForceReply != nil:
+		force := *m.ForceReply
+		force.ForceReply = true
+		return json.Marshal(force)
+	}
+	return []byte("null"), nil
+}
+
+// MarshalJSON encodes whichever member of the union is set, filling in its type field
+func (r InlineQueryResult) MarshalJSON() ([]byte, error) {
+	if r.Article != nil {
+		article := *r.Article
+		article.Type = "article"
+		return json.Marshal(article)
+	}
+	return []byte("null"), nil
+}
+
+// MarshalJSON encodes whichever member of the union is set. The members have no type field:
+// Telegram tells them apart by their fields
+func (c InputMessageContent) MarshalJSON() ([]byte, error) {
+	if c.Text != nil {
+		return json.Marshal(c.Text)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON decodes the member of the union named by the "source" field.
+// A source this library doesn't know yet goes in Unknown, so that it doesn't fail the whole update
+func (s *ChatBoostSource) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Source string `json:"source"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	*s = ChatBoostSource{}
+	switch probe.Source {
+	case "premium":
+		s.Premium = new(ChatBoostSourcePremium)
+		return json.Unmarshal(data, s.Premium)
+	case "gift_code":
+		s.GiftCode = new(ChatBoostSourceGiftCode)
+		return json.Unmarshal(data, s.GiftCode)
+	case "giveaway":
+		s.Giveaway = new(ChatBoostSourceGiveaway)
+		return json.Unmarshal(data, s.Giveaway)
+	}
+	s.Unknown = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON encodes whichever member of the union is set, filling in its source field
+func (s ChatBoostSource) MarshalJSON() ([]byte, error) {
+	switch {
+	case s.Premium != nil:
+		premium := *s.Premium
+		premium.Source = "premium"
+		return json.Marshal(premium)
+	case s.GiftCode != nil:
+		giftCode := *s.GiftCode
+		giftCode.Source = "gift_code"
+		return json.Marshal(giftCode)
+	case s.Giveaway != nil:
+		giveaway := *s.Giveaway
+		giveaway.Source = "giveaway"
+		return json.Marshal(giveaway)
+	case s.Unknown != nil:
+		return s.Unknown, nil
+	}
+	return []byte("null"), nil
+}
+
+// User returns the user behind the boost, if known.
+// It is nil only for a giveaway boost that has no winner (yet)
+func (s *ChatBoostSource) User() *User {
+	switch {
+	case s.Premium != nil:
+		return &s.Premium.User
+	case s.GiftCode != nil:
+		return &s.GiftCode.User
+	case s.Giveaway != nil:
+		return s.Giveaway.User
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes the member of the union named by the "type" field, or keeps an unknown type in Unknown
+func (r *ReactionType) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	*r = ReactionType{}
+	switch probe.Type {
+	case "emoji":
+		r.Emoji = new(ReactionTypeEmoji)
+		return json.Unmarshal(data, r.Emoji)
+	case "custom_emoji":
+		r.CustomEmoji = new(ReactionTypeCustomEmoji)
+		return json.Unmarshal(data, r.CustomEmoji)
+	case "paid":
+		r.Paid = new(ReactionTypePaid)
+		return json.Unmarshal(data, r.Paid)
+	}
+	r.Unknown = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON encodes whichever member of the union is set, filling in its type field
+func (r ReactionType) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.Emoji != nil:
+		emoji := *r.Emoji
+		emoji.Type = "emoji"
+		return json.Marshal(emoji)
+	case r.CustomEmoji != nil:
+		customEmoji := *r.CustomEmoji
+		customEmoji.Type = "custom_emoji"
+		return json.Marshal(customEmoji)
+	case r.Paid != nil:
+		return json.Marshal(ReactionTypePaid{Type: "paid"})
+	case r.Unknown != nil:
+		return r.Unknown, nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON decodes the member of the union named by the "status" field, or keeps an unknown status in Unknown
+func (m *ChatMember) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	*m = ChatMember{}
+	switch probe.Status {
+	case "creator":
+		m.Owner = new(ChatMemberOwner)
+		return json.Unmarshal(data, m.Owner)
+	case "administrator":
+		m.Administrator = new(ChatMemberAdministrator)
+		return json.Unmarshal(data, m.Administrator)
+	case "member":
+		m.Member = new(ChatMemberMember)
+		return json.Unmarshal(data, m.Member)
+	case "restricted":
+		m.Restricted = new(ChatMemberRestricted)
+		return json.Unmarshal(data, m.Restricted)
+	case "left":
+		m.Left = new(ChatMemberLeft)
+		return json.Unmarshal(data, m.Left)
+	case "kicked":
+		m.Banned = new(ChatMemberBanned)
+		return json.Unmarshal(data, m.Banned)
+	}
+	m.Unknown = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON encodes whichever member of the union is set, filling in its status field
+func (m ChatMember) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Owner != nil:
+		owner := *m.Owner
+		owner.Status = "creator"
+		return json.Marshal(owner)
+	case m.Administrator != nil:
+		admin := *m.Administrator
+		admin.Status = "administrator"
+		return json.Marshal(admin)
+	case m.Member != nil:
+		member := *m.Member
+		member.Status = "member"
+		return json.Marshal(member)
+	case m.Restricted != nil:
+		restricted := *m.Restricted
+		restricted.Status = "restricted"
+		return json.Marshal(restricted)
+	case m.Left != nil:
+		left := *m.Left
+		left.Status = "left"
+		return json.Marshal(left)
+	case m.Banned != nil:
+		banned := *m.Banned
+		banned.Status = "kicked"
+		return json.Marshal(banned)
+	case m.Unknown != nil:
+		return m.Unknown, nil
+	}
+	return []byte("null"), nil
+}
+
+// Status returns the status of the member as named by the Bot API: "creator", "administrator",
+// "member", "restricted", "left" or "kicked"
+func (m *ChatMember) Status() string {
+	switch {
+	case m.Owner != nil:
+		return "creator"
+	case m.Administrator != nil:
+		return "administrator"
+	case m.Member != nil:
+		return "member"
+	case m.Restricted != nil:
+		return "restricted"
+	case m.Left != nil:
+		return "left"
+	case m.Banned != nil:
+		return "kicked"
+	}
+	return ""
+}
+
+// User returns the user the membership is about, whatever the status
+func (m *ChatMember) User() *User {
+	switch {
+	case m.Owner != nil:
+		return &m.Owner.User
+	case m.Administrator != nil:
+		return &m.Administrator.User
+	case m.Member != nil:
+		return &m.Member.User
+	case m.Restricted != nil:
+		return &m.Restricted.User
+	case m.Left != nil:
+		return &m.Left.User
+	case m.Banned != nil:
+		return &m.Banned.User
+	}
+	return nil
+}
+
+// MarshalJSON encodes whichever member of the union is set, filling in its type field.
+// An empty scope is the default one
+func (s BotCommandScope) MarshalJSON() ([]byte, error) {
+	switch {
+	case s.AllPrivateChats != nil:
+		return json.Marshal(BotCommandScopeAllPrivateChats{Type: "all_private_chats"})
+	case s.AllGroupChats != nil:
+		return json.Marshal(BotCommandScopeAllGroupChats{Type: "all_group_chats"})
+	case s.AllChatAdministrators != nil:
+		return json.Marshal(BotCommandScopeAllChatAdministrators{Type: "all_chat_administrators"})
+	case s.Chat != nil:
+		chat := *s.Chat
+		chat.Type = "chat"
+		return json.Marshal(chat)
+	case s.ChatAdministrators != nil:
+		admins := *s.ChatAdministrators
+		admins.Type = "chat_administrators"
+		return json.Marshal(admins)
+	case s.ChatMember != nil:
+		member := *s.ChatMember
+		member.Type = "chat_member"
+		return json.Marshal(member)
+	}
+	return json.Marshal(BotCommandScopeDefault{Type: "default"})
+}
+
+// UnmarshalJSON decodes the member of the union named by the "type" field, or keeps an unknown type in Unknown
+func (o *MessageOrigin) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	*o = MessageOrigin{}
+	switch probe.Type {
+	case "user":
+		o.User = new(MessageOriginUser)
+		return json.Unmarshal(data, o.User)
+	case "hidden_user":
+		o.HiddenUser = new(MessageOriginHiddenUser)
+		return json.Unmarshal(data, o.HiddenUser)
+	case "chat":
+		o.Chat = new(MessageOriginChat)
+		return json.Unmarshal(data, o.Chat)
+	case "channel":
+		o.Channel = new(MessageOriginChannel)
+		return json.Unmarshal(data, o.Channel)
+	}
+	o.Unknown = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON encodes whichever member of the union is set, filling in its type field
+func (o MessageOrigin) MarshalJSON() ([]byte, error) {
+	switch {
+	case o.User != nil:
+		user := *o.User
+		user.Type = "user"
+		return json.Marshal(user)
+	case o.HiddenUser != nil:
+		hidden := *o.HiddenUser
+		hidden.Type = "hidden_user"
+		return json.Marshal(hidden)
+	case o.Chat != nil:
+		chat := *o.Chat
+		chat.Type = "chat"
+		return json.Marshal(chat)
+	case o.Channel != nil:
+		channel := *o.Channel
+		channel.Type = "channel"
+		return json.Marshal(channel)
+	case o.Unknown != nil:
+		return o.Unknown, nil
+	}
+	return []byte("null"), nil
+}
+
+// Date returns the date the message was sent originally in Unix time, whatever the origin
+func (o *MessageOrigin) Date() int64 {
+	switch {
+	case o.User != nil:
+		return o.User.Date
+	case o.HiddenUser != nil:
+		return o.HiddenUser.Date
+	case o.Chat != nil:
+		return o.Chat.Date
+	case o.Channel != nil:
+		return o.Channel.Date
+	}
+	return 0
+}
@@ -0,0 +1,180 @@
+/* update.go : helpers on incoming updates
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import "encoding/json"
+
+// Names of the kinds of update, as used in allowed_updates and returned by Update.Type
+const (
+	UpdateTypeMessage            = "message"
+	UpdateTypeEditedMessage      = "edited_message"
+	UpdateTypeChannelPost        = "channel_post"
+	UpdateTypeEditedChannelPost  = "edited_channel_post"
+	UpdateTypeInlineQuery        = "inline_query"
+	UpdateTypeCallbackQuery      = "callback_query"
+	UpdateTypePurchasedPaidMedia = "purchased_paid_media"
+	UpdateTypeChatBoost          = "chat_boost"
+	UpdateTypeRemovedChatBoost   = "removed_chat_boost"
+	UpdateTypeShippingQuery      = "shipping_query"
+	UpdateTypeMyChatMember       = "my_chat_member"
+	UpdateTypeChatMember         = "chat_member"
+
+	UpdateTypeBusinessMessage       = "business_message"
+	UpdateTypeEditedBusinessMessage = "edited_business_message"
+)
+
+// The update types modeled by this library, in the order of the fields of Update
+var updateTypes = []string{
+	UpdateTypeMessage, UpdateTypeEditedMessage, UpdateTypeChannelPost, UpdateTypeEditedChannelPost,
+	UpdateTypeInlineQuery, UpdateTypeCallbackQuery, UpdateTypePurchasedPaidMedia, UpdateTypeChatBoost,
+	UpdateTypeRemovedChatBoost, UpdateTypeShippingQuery, UpdateTypeMyChatMember, UpdateTypeChatMember,
+	UpdateTypeBusinessMessage, UpdateTypeEditedBusinessMessage,
+}
+
+// Type returns the name of the optional field set in the update (see the UpdateType constants),
+// or "" if it is a kind of update this library doesn't model yet
+func (u *Update) Type() string {
+	switch {
+	case u.Message != nil:
+		return UpdateTypeMessage
+	case u.EditedMessage != nil:
+		return UpdateTypeEditedMessage
+	case u.ChannelPost != nil:
+		return UpdateTypeChannelPost
+	case u.EditedChannelPost != nil:
+		return UpdateTypeEditedChannelPost
+	case u.InlineQuery != nil:
+		return UpdateTypeInlineQuery
+	case u.CallbackQuery != nil:
+		return UpdateTypeCallbackQuery
+	case u.PurchasedPaidMedia != nil:
+		return UpdateTypePurchasedPaidMedia
+	case u.ChatBoost != nil:
+		return UpdateTypeChatBoost
+	case u.RemovedChatBoost != nil:
+		return UpdateTypeRemovedChatBoost
+	case u.ShippingQuery != nil:
+		return UpdateTypeShippingQuery
+	case u.MyChatMember != nil:
+		return UpdateTypeMyChatMember
+	case u.ChatMember != nil:
+		return UpdateTypeChatMember
+	case u.BusinessMessage != nil:
+		return UpdateTypeBusinessMessage
+	case u.EditedBusinessMessage != nil:
+		return UpdateTypeEditedBusinessMessage
+	}
+	return ""
+}
+
+// IsChannelPost reports whether the update comes from a channel (channel_post or edited_channel_post).
+// Channels don't send "message" updates: a bot that manages a channel must look at these
+func (u *Update) IsChannelPost() bool {
+	return u.ChannelPost != nil || u.EditedChannelPost != nil
+}
+
+// IsEdit reports whether the update carries a new version of a message
+// or channel post that the bot has already seen (edited_message, edited_channel_post
+// or edited_business_message)
+func (u *Update) IsEdit() bool {
+	return u.EditedMessage != nil || u.EditedChannelPost != nil || u.EditedBusinessMessage != nil
+}
+
+// EffectiveMessage returns the message the update is about, whatever field it is in:
+// Message, EditedMessage, ChannelPost, EditedChannelPost, BusinessMessage or EditedBusinessMessage. It is nil for updates without one
+// (the message of a callback query is not returned, since it may be inaccessible)
+func (u *Update) EffectiveMessage() *Message {
+	switch {
+	case u.Message != nil:
+		return u.Message
+	case u.EditedMessage != nil:
+		return u.EditedMessage
+	case u.ChannelPost != nil:
+		return u.ChannelPost
+	case u.EditedChannelPost != nil:
+		return u.EditedChannelPost
+	case u.BusinessMessage != nil:
+		return u.BusinessMessage
+	case u.EditedBusinessMessage != nil:
+		return u.EditedBusinessMessage
+	}
+	return nil
+}
+
+// EffectiveUser returns the user that caused the update, or nil if there is none
+// (e.g. a channel post, or a boost given by an unknown user). Messages sent on behalf of a chat
+// have no user either: their From is a placeholder bot, see EffectiveSenderChat
+func (u *Update) EffectiveUser() *User {
+	if m := u.EffectiveMessage(); m != nil {
+		if m.SenderChat != nil {
+			return nil
+		}
+		return m.From
+	}
+	switch {
+	case u.InlineQuery != nil:
+		return &u.InlineQuery.From
+	case u.CallbackQuery != nil:
+		return &u.CallbackQuery.From
+	case u.PurchasedPaidMedia != nil:
+		return &u.PurchasedPaidMedia.From
+	case u.ChatBoost != nil:
+		return u.ChatBoost.Boost.Source.User()
+	case u.RemovedChatBoost != nil:
+		return u.RemovedChatBoost.Source.User()
+	case u.ShippingQuery != nil:
+		return &u.ShippingQuery.From
+	case u.MyChatMember != nil:
+		return &u.MyChatMember.From
+	case u.ChatMember != nil:
+		return &u.ChatMember.From
+	}
+	return nil
+}
+
+// EffectiveSenderChat returns the chat on behalf of which the message of the update was sent:
+// the group itself for its anonymous administrators, a channel for its posts and for the messages
+// sent as the channel in a group (like the comments of a channel's discussion group).
+// It is nil when the message comes from a user, or the update has no message
+func (u *Update) EffectiveSenderChat() *Chat {
+	if m := u.EffectiveMessage(); m != nil {
+		return m.SenderChat
+	}
+	return nil
+}
+
+// EffectiveChat returns the chat where the update happened, or nil if there is none
+// (e.g. an inline query, which doesn't belong to a chat)
+func (u *Update) EffectiveChat() *Chat {
+	if m := u.EffectiveMessage(); m != nil {
+		return &m.Chat
+	}
+	switch {
+	case u.CallbackQuery != nil && u.CallbackQuery.Message != nil:
+		chat := u.CallbackQuery.Message.Chat()
+		return &chat
+	case u.ChatBoost != nil:
+		return &u.ChatBoost.Chat
+	case u.RemovedChatBoost != nil:
+		return &u.RemovedChatBoost.Chat
+	case u.MyChatMember != nil:
+		return &u.MyChatMember.Chat
+	case u.ChatMember != nil:
+		return &u.ChatMember.Chat
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes the update as usual, and keeps a copy of the JSON in Raw
+func (u *Update) UnmarshalJSON(data []byte) error {
+	type plain Update // same fields without this method
+	if err := json.Unmarshal(data, (*plain)(u)); err != nil {
+		return err
+	}
+	u.Raw = append(json.RawMessage(nil), data...) // data may be reused by the caller
+	return nil
+}
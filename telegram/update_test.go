@@ -0,0 +1,389 @@
+/* update_test.go : tests for the helpers on incoming updates
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestChannelPostUpdate(t *testing.T) {
+	const post = `{"message_id":12,"date":1700000000,"chat":{"id":-1009876543210,"type":"channel","title":"News","username":"news"},"sender_chat":{"id":-1009876543210,"type":"channel","title":"News","username":"news"},"text":"breaking"}`
+	tests := []struct {
+		name     string
+		json     string
+		wantType string
+		wantEdit bool
+	}{
+		{name: "channel post", json: `{"update_id":1,"channel_post":` + post + `}`, wantType: UpdateTypeChannelPost},
+		{name: "edited channel post", json: `{"update_id":2,"edited_channel_post":` + post + `}`, wantType: UpdateTypeEditedChannelPost, wantEdit: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u Update
+			if err := json.Unmarshal([]byte(tt.json), &u); err != nil {
+				t.Fatal(err)
+			}
+			if got := u.Type(); got != tt.wantType {
+				t.Errorf("Type() = %q, want %q", got, tt.wantType)
+			}
+			if !u.IsChannelPost() {
+				t.Error("IsChannelPost() = false")
+			}
+			if got := u.IsEdit(); got != tt.wantEdit {
+				t.Errorf("IsEdit() = %v, want %v", got, tt.wantEdit)
+			}
+			if u.Message != nil {
+				t.Error("a channel post was decoded as a message")
+			}
+
+			chat := u.EffectiveChat()
+			if chat == nil || chat.ID != -1009876543210 || chat.Type != "channel" || chat.Title != "News" {
+				t.Errorf("EffectiveChat() = %+v", chat)
+			}
+			if m := u.EffectiveMessage(); m == nil || m.Text != "breaking" {
+				t.Errorf("EffectiveMessage() = %+v", m)
+			}
+			if user := u.EffectiveUser(); user != nil {
+				t.Errorf("EffectiveUser() = %+v, want nil", user)
+			}
+		})
+	}
+}
+
+func TestBusinessMessageUpdate(t *testing.T) {
+	const message = `{"message_id":5,"date":1700000000,"chat":{"id":7,"type":"private","first_name":"Ann"},"from":{"id":42,"is_bot":false,"first_name":"Shop"},"sender_business_bot":{"id":99,"is_bot":true,"first_name":"Assistant","username":"assistantbot"},"business_connection_id":"bc1","text":"your order shipped"}`
+	tests := []struct {
+		name     string
+		json     string
+		wantType string
+		wantEdit bool
+	}{
+		{name: "business message", json: `{"update_id":1,"business_message":` + message + `}`, wantType: UpdateTypeBusinessMessage},
+		{name: "edited business message", json: `{"update_id":2,"edited_business_message":` + message + `}`, wantType: UpdateTypeEditedBusinessMessage, wantEdit: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u Update
+			if err := json.Unmarshal([]byte(tt.json), &u); err != nil {
+				t.Fatal(err)
+			}
+			if got := u.Type(); got != tt.wantType {
+				t.Errorf("Type() = %q, want %q", got, tt.wantType)
+			}
+			if got := u.IsEdit(); got != tt.wantEdit {
+				t.Errorf("IsEdit() = %v, want %v", got, tt.wantEdit)
+			}
+			if u.Message != nil {
+				t.Error("a business message was decoded as a message")
+			}
+
+			m := u.EffectiveMessage()
+			if m == nil || m.Text != "your order shipped" {
+				t.Fatalf("EffectiveMessage() = %+v", m)
+			}
+			if !m.IsBusiness() || m.BusinessConnectionID != "bc1" {
+				t.Errorf("IsBusiness() = %v with connection %q", m.IsBusiness(), m.BusinessConnectionID)
+			}
+			if bot := m.SenderBusinessBot; bot == nil || bot.ID != 99 || !bot.IsBot || bot.Username != "assistantbot" {
+				t.Errorf("SenderBusinessBot = %+v", bot)
+			}
+
+			// The answer must go through the same connection
+			reply := m.Reply("thanks")
+			if reply.BusinessConnectionID != "bc1" || reply.ChatID != NewChatID(7) {
+				t.Errorf("Reply() = %+v", reply.BaseSendParams)
+			}
+			data, err := json.Marshal(reply)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(data), `"business_connection_id":"bc1"`) {
+				t.Errorf("reply body %s has no business_connection_id", data)
+			}
+		})
+	}
+
+	t.Run("not a business message", func(t *testing.T) {
+		m := Message{MessageID: 5, Chat: Chat{ID: 7, Type: "private"}, Text: "hi"}
+		if m.IsBusiness() {
+			t.Error("IsBusiness() = true")
+		}
+		data, err := json.Marshal(m.Reply("hello"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(data), "business_connection_id") {
+			t.Errorf("reply body %s has a business_connection_id", data)
+		}
+	})
+}
+
+func TestUpdateUnknownFields(t *testing.T) {
+	t.Run("unknown fields", func(t *testing.T) {
+		const data = `{
+			"update_id": 77,
+			"future_update": {"id": "x"},
+			"message": {
+				"message_id": 1, "date": 1700000000, "chat": {"id": 7, "type": "private", "future_chat_field": 1},
+				"text": "hi", "future_message_field": {"nested": [1, 2, 3]}
+			}
+		}`
+		var u Update
+		if err := json.Unmarshal([]byte(data), &u); err != nil {
+			t.Fatalf("unknown fields made decoding fail: %v", err)
+		}
+		if u.UpdateID != 77 || u.Message == nil || u.Message.Text != "hi" {
+			t.Errorf("decoded %+v", u)
+		}
+
+		var raw struct {
+			FutureUpdate struct {
+				ID string `json:"id"`
+			} `json:"future_update"`
+			Message struct {
+				FutureMessageField struct {
+					Nested []int `json:"nested"`
+				} `json:"future_message_field"`
+			} `json:"message"`
+		}
+		if err := json.Unmarshal(u.Raw, &raw); err != nil {
+			t.Fatalf("Raw is not valid JSON: %v", err)
+		}
+		if raw.FutureUpdate.ID != "x" || len(raw.Message.FutureMessageField.Nested) != 3 {
+			t.Errorf("Raw lost the unknown fields: %s", u.Raw)
+		}
+
+		// Raw is not sent back
+		out, err := json.Marshal(&u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(out), "future") {
+			t.Errorf("unknown fields were marshaled: %s", out)
+		}
+	})
+
+	t.Run("unknown union members", func(t *testing.T) {
+		const member = `{"user":{"id":42,"is_bot":false,"first_name":"Ann"},"status":"future"}`
+		tests := []struct {
+			name    string
+			json    string
+			unknown func(u *Update) json.RawMessage
+		}{
+			{
+				name: "chat member status",
+				json: `{"update_id":1,"chat_member":{"chat":{"id":-100,"type":"supergroup"},"from":{"id":1,"is_bot":false,"first_name":"Bob"},"date":0,` +
+					`"old_chat_member":` + member + `,"new_chat_member":{"user":{"id":42,"is_bot":false,"first_name":"Ann"},"status":"member"}}}`,
+				unknown: func(u *Update) json.RawMessage { return u.ChatMember.OldChatMember.Unknown },
+			},
+			{
+				name:    "chat boost source",
+				json:    `{"update_id":2,"chat_boost":{"chat":{"id":-100,"type":"channel"},"boost":{"boost_id":"b","add_date":0,"expiration_date":0,"source":{"source":"future","stars":5}}}}`,
+				unknown: func(u *Update) json.RawMessage { return u.ChatBoost.Boost.Source.Unknown },
+			},
+			{
+				name:    "message origin",
+				json:    `{"update_id":3,"message":{"message_id":1,"date":0,"chat":{"id":7,"type":"private"},"external_reply":{"origin":{"type":"future","date":0}}}}`,
+				unknown: func(u *Update) json.RawMessage { return u.Message.ExternalReply.Origin.Unknown },
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var u Update
+				if err := json.Unmarshal([]byte(tt.json), &u); err != nil {
+					t.Fatalf("an unknown kind made decoding fail: %v", err)
+				}
+				if unknown := tt.unknown(&u); !strings.Contains(string(unknown), `"future"`) {
+					t.Errorf("Unknown = %s, want the JSON of the unknown kind", unknown)
+				}
+			})
+		}
+
+		var chat ChatFullInfo
+		data := `{"id":-100,"type":"supergroup","available_reactions":[{"type":"emoji","emoji":"👍"},{"type":"future"}]}`
+		if err := json.Unmarshal([]byte(data), &chat); err != nil {
+			t.Fatalf("an unknown reaction type made decoding fail: %v", err)
+		}
+		if len(chat.AvailableReactions) != 2 || chat.AvailableReactions[0].Emoji == nil || chat.AvailableReactions[1].Unknown == nil {
+			t.Errorf("AvailableReactions = %+v", chat.AvailableReactions)
+		}
+		out, err := json.Marshal(chat.AvailableReactions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := `[{"type":"emoji","emoji":"👍"},{"type":"future"}]`; string(out) != want {
+			t.Errorf("marshaled %s, want %s", out, want)
+		}
+	})
+}
+
+func TestUpdateRawIsACopy(t *testing.T) {
+	data := []byte(`{"update_id":1,"message":{"message_id":1,"date":0,"chat":{"id":7,"type":"private"}}}`)
+	var u Update
+	if err := json.Unmarshal(data, &u); err != nil {
+		t.Fatal(err)
+	}
+	want := string(data)
+	for i := range data {
+		data[i] = ' '
+	}
+	if string(u.Raw) != want {
+		t.Errorf("Raw changed with the input: %q", u.Raw)
+	}
+}
+
+func TestSenderChat(t *testing.T) {
+	const (
+		group   = `{"id":-1001234567890,"type":"supergroup","title":"Group"}`
+		channel = `{"id":-1009876543210,"type":"channel","title":"News","username":"news"}`
+		anonBot = `{"id":1087968824,"is_bot":true,"first_name":"Group","username":"GroupAnonymousBot"}`
+		chanBot = `{"id":136817688,"is_bot":true,"first_name":"Channel","username":"Channel_Bot"}`
+	)
+	tests := []struct {
+		name          string
+		json          string
+		wantAnonymous bool
+		wantAsChannel bool
+		wantUser      int64 // 0 if EffectiveUser should be nil
+		wantSender    int64 // 0 if EffectiveSenderChat should be nil
+		wantString    string
+	}{
+		{
+			name:          "anonymous administrator",
+			json:          `{"update_id":1,"message":{"message_id":5,"date":1700000000,"chat":` + group + `,"from":` + anonBot + `,"sender_chat":` + group + `,"text":"hi"}}`,
+			wantAnonymous: true,
+			wantSender:    -1001234567890,
+			wantString:    "update 1 (message, chat -1001234567890)",
+		},
+		{
+			name:          "comment sent as a channel",
+			json:          `{"update_id":2,"message":{"message_id":6,"date":1700000000,"chat":` + group + `,"from":` + chanBot + `,"sender_chat":` + channel + `,"text":"hi"}}`,
+			wantAsChannel: true,
+			wantSender:    -1009876543210,
+			wantString:    "update 2 (message, chat -1001234567890, sender chat -1009876543210)",
+		},
+		{
+			name:       "ordinary user",
+			json:       `{"update_id":3,"message":{"message_id":7,"date":1700000000,"chat":` + group + `,"from":{"id":42,"is_bot":false,"first_name":"Ann"},"text":"hi"}}`,
+			wantUser:   42,
+			wantString: "update 3 (message, chat -1001234567890, user 42)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u Update
+			if err := json.Unmarshal([]byte(tt.json), &u); err != nil {
+				t.Fatal(err)
+			}
+			m := u.Message
+			if got := m.IsAnonymousAdmin(); got != tt.wantAnonymous {
+				t.Errorf("IsAnonymousAdmin() = %v, want %v", got, tt.wantAnonymous)
+			}
+			if got := m.IsSentAsChannel(); got != tt.wantAsChannel {
+				t.Errorf("IsSentAsChannel() = %v, want %v", got, tt.wantAsChannel)
+			}
+			if m.From == nil {
+				t.Error("From was not decoded")
+			}
+
+			user := u.EffectiveUser()
+			switch {
+			case tt.wantUser == 0 && user != nil:
+				t.Errorf("EffectiveUser() = %+v, want nil", user)
+			case tt.wantUser != 0 && (user == nil || user.ID != tt.wantUser):
+				t.Errorf("EffectiveUser() = %+v, want user %d", user, tt.wantUser)
+			}
+			sender := u.EffectiveSenderChat()
+			switch {
+			case tt.wantSender == 0 && sender != nil:
+				t.Errorf("EffectiveSenderChat() = %+v, want nil", sender)
+			case tt.wantSender != 0 && (sender == nil || sender.ID != tt.wantSender):
+				t.Errorf("EffectiveSenderChat() = %+v, want chat %d", sender, tt.wantSender)
+			}
+			if chat := u.EffectiveChat(); chat == nil || chat.ID != -1001234567890 {
+				t.Errorf("EffectiveChat() = %+v, want the group", chat)
+			}
+			if got := u.String(); got != tt.wantString {
+				t.Errorf("String() = %q, want %q", got, tt.wantString)
+			}
+		})
+	}
+}
+
+func TestPurchasedPaidMediaUpdate(t *testing.T) {
+	const data = `{"update_id":5,"purchased_paid_media":{"from":{"id":42,"is_bot":false,"first_name":"Ann"},"paid_media_payload":"album-7"}}`
+	var u Update
+	if err := json.Unmarshal([]byte(data), &u); err != nil {
+		t.Fatal(err)
+	}
+	if u.PurchasedPaidMedia == nil || u.PurchasedPaidMedia.PaidMediaPayload != "album-7" {
+		t.Fatalf("PurchasedPaidMedia = %+v", u.PurchasedPaidMedia)
+	}
+	if got := u.Type(); got != UpdateTypePurchasedPaidMedia {
+		t.Errorf("Type() = %q, want %q", got, UpdateTypePurchasedPaidMedia)
+	}
+	if user := u.EffectiveUser(); user == nil || user.ID != 42 {
+		t.Errorf("EffectiveUser() = %+v, want the buyer", user)
+	}
+	if chat := u.EffectiveChat(); chat != nil {
+		t.Errorf("EffectiveChat() = %+v, want nil", chat)
+	}
+}
+
+func TestLiveLocationUpdates(t *testing.T) {
+	const msg = `"message_id":21,"date":1700000000,"chat":{"id":42,"type":"private"},"from":{"id":42,"is_bot":false,"first_name":"Ann"}`
+	updates := []string{
+		`{"update_id":1,"message":{` + msg + `,"location":{"latitude":45.4642,"longitude":9.19,"horizontal_accuracy":12.5,"live_period":3600,"heading":90,"proximity_alert_radius":200}}}`,
+		`{"update_id":2,"edited_message":{` + msg + `,"edit_date":1700000060,"location":{"latitude":45.4655,"longitude":9.1912,"horizontal_accuracy":8,"live_period":3600,"heading":95}}}`,
+	}
+	var first, edit Update
+	if err := json.Unmarshal([]byte(updates[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(updates[1]), &edit); err != nil {
+		t.Fatal(err)
+	}
+
+	loc := first.Message.Location
+	if loc == nil || loc.Latitude != 45.4642 || loc.Longitude != 9.19 || loc.HorizontalAccuracy != 12.5 ||
+		loc.LivePeriod != 3600 || loc.Heading != 90 || loc.ProximityAlertRadius != 200 {
+		t.Errorf("Location = %+v", loc)
+	}
+
+	if edit.Type() != UpdateTypeEditedMessage {
+		t.Fatalf("Type() = %q", edit.Type())
+	}
+	m := edit.EffectiveMessage()
+	if m.MessageID != first.Message.MessageID || m.EditDateUnix != 1700000060 {
+		t.Errorf("edited message %d at %d", m.MessageID, m.EditDateUnix)
+	}
+	if loc := m.Location; loc == nil || loc.Latitude != 45.4655 || loc.Longitude != 9.1912 || loc.Heading != 95 || loc.ProximityAlertRadius != 0 {
+		t.Errorf("edited Location = %+v", loc)
+	}
+}
+
+func TestDecodeVenue(t *testing.T) {
+	const data = `{"message_id":22,"date":1700000000,"chat":{"id":42,"type":"private"},
+		"venue":{"location":{"latitude":45.4641,"longitude":9.1919},"title":"Duomo","address":"Piazza del Duomo, Milano",
+		"foursquare_id":"4b0d5ef6f964a520d24423e3","foursquare_type":"arts_entertainment/default","google_place_id":"ChIJ1234","google_place_type":"church"},
+		"location":{"latitude":45.4641,"longitude":9.1919}}`
+	var m Message
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	v := m.Venue
+	if v == nil || v.Location.Latitude != 45.4641 || v.Title != "Duomo" || v.Address != "Piazza del Duomo, Milano" ||
+		v.FoursquareID != "4b0d5ef6f964a520d24423e3" || v.FoursquareType != "arts_entertainment/default" ||
+		v.GooglePlaceID != "ChIJ1234" || v.GooglePlaceType != "church" {
+		t.Errorf("Venue = %+v", v)
+	}
+	if m.Location == nil || m.Location.Longitude != 9.1919 {
+		t.Errorf("Location = %+v", m.Location)
+	}
+}
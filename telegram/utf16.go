@@ -0,0 +1,51 @@
+/* utf16.go : UTF-16 helpers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ *
+ * Go strings are UTF-8, but Telegram measures offsets and lengths of entities
+ * (and the limits on text length) in UTF-16 code units. A character outside the
+ * BMP, like most emoji, counts as 2 units, so len() and utf8.RuneCountInString()
+ * both give the wrong answer.
+ */
+
+package telegram
+
+import "unicode/utf16"
+
+// UTF16Length returns the length of s in UTF-16 code units, the unit Telegram uses
+// for entity offsets and text length limits
+func UTF16Length(s string) int64 {
+	var n int64
+	for _, r := range s {
+		n += int64(utf16.RuneLen(r))
+	}
+	return n
+}
+
+// EntityText returns the part of text covered by the entity.
+// Out of range offsets are clipped instead of panicking, since entities come from the network
+func EntityText(text string, e MessageEntity) string {
+	return entityText(utf16.Encode([]rune(text)), e)
+}
+
+// Same as EntityText, on a text already encoded, for when there are many entities
+func entityText(units []uint16, e MessageEntity) string {
+	start, end := e.Offset, e.Offset+e.Length
+	if start < 0 {
+		start = 0
+	}
+	if end > int64(len(units)) {
+		end = int64(len(units))
+	}
+	if start >= end {
+		return ""
+	}
+	return string(utf16.Decode(units[start:end]))
+}
+
+// Reports whether u is the second half of a surrogate pair,
+// i.e. cutting right before it would split a character in two
+func isLowSurrogate(u uint16) bool {
+	return u >= 0xDC00 && u <= 0xDFFF
+}
@@ -0,0 +1,252 @@
+/* webhook.go : webhooks
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+// The header in which Telegram sends the secret_token of setWebhook with every webhook request
+const WebhookSecretHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// Webhooks can only be set up on these ports. This matters for self-hosted servers
+// with a self-signed certificate, which usually listen on 8443 (and the server must
+// listen on the port of the URL, since that's where Telegram connects)
+var webhookPorts = map[string]bool{"443": true, "80": true, "88": true, "8443": true}
+
+// Validate checks the parameters of setWebhook
+func (p *SetWebhookParams) Validate() error {
+	if p.URL != "" {
+		u, err := url.Parse(p.URL)
+		if err != nil {
+			return fmt.Errorf("telegram: invalid webhook URL: %w", err)
+		}
+		if u.Scheme != "https" {
+			return fmt.Errorf("telegram: webhook URL must use https, got %q", u.Scheme)
+		}
+	}
+	if p.MaxConnections != 0 && (p.MaxConnections < 1 || p.MaxConnections > 100) {
+		return fmt.Errorf("telegram: max_connections must be 1-100, got %d", p.MaxConnections)
+	}
+	if p.SecretToken != "" {
+		if err := validateSecretToken(p.SecretToken); err != nil {
+			return fmt.Errorf("telegram: %w", err)
+		}
+	}
+	if p.Certificate != nil && p.Certificate.Reader == nil {
+		return fmt.Errorf("telegram: the webhook certificate has no content")
+	}
+	return nil
+}
+
+// Warnings returns the problems of the parameters that Validate lets through, because Telegram
+// might still accept them: for now, a URL with a port other than 443, 80, 88 or 8443
+func (p *SetWebhookParams) Warnings() []string {
+	var warnings []string
+	if u, err := url.Parse(p.URL); err == nil {
+		if port := u.Port(); port != "" && !webhookPorts[port] {
+			warnings = append(warnings, fmt.Sprintf("webhooks can only use ports 443, 80, 88 or 8443, got %s", port))
+		}
+	}
+	return warnings
+}
+
+// SetWebhook calls setWebhook, uploading params.Certificate with multipart/form-data when it is set.
+// Check params.Warnings before: Validate doesn't reject the ports Telegram doesn't support
+func SetWebhook(ctx context.Context, client *http.Client, token string, params SetWebhookParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if params.Certificate == nil {
+		_, err := callBool(ctx, client, token, "setWebhook", params)
+		return err
+	}
+	data, err := postMultipart(ctx, client, token, "setWebhook", params, map[string]*InputFile{"certificate": params.Certificate})
+	if err != nil {
+		return err
+	}
+	_, err = DecodeBoolResponse(data)
+	return err
+}
+
+// GenerateWebhookSecret returns a random secret_token for setWebhook: 32 random bytes
+// encoded as URL-safe base64 without padding, which only uses the allowed characters (A-Z, a-z, 0-9, _ and -).
+// Pass the same value to setWebhook and to VerifyWebhookSecret
+func GenerateWebhookSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b) // never fails, see crypto/rand
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// VerifyWebhookSecret reports whether the request carries the given secret in the WebhookSecretHeader,
+// i.e. whether it really comes from Telegram. Webhook handlers should reject the requests that fail it.
+// The comparison takes constant time, so the secret can't be guessed byte by byte.
+// An empty secret verifies nothing: the result is always false, even for a request without the header
+func VerifyWebhookSecret(r *http.Request, secret string) bool {
+	if secret == "" {
+		return false
+	}
+	got := r.Header.Get(WebhookSecretHeader)
+	return subtle.ConstantTimeCompare([]byte(got), []byte(secret)) == 1
+}
+
+func validateSecretToken(token string) error {
+	if len(token) > 256 {
+		return fmt.Errorf("secret token is %d characters long, the limit is 256", len(token))
+	}
+	for _, r := range token {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+		default:
+			return fmt.Errorf("secret token can't contain %q", r)
+		}
+	}
+	return nil
+}
+
+// Update types that are not delivered when allowed_updates is empty, they must be asked for explicitly
+var optInUpdateTypes = map[string]bool{"chat_member": true, "message_reaction": true, "message_reaction_count": true}
+
+// DroppedUpdates returns the update types the bot currently receives (according to info, the result
+// of getWebhookInfo) that it would stop receiving after setWebhook with p.
+// If AllowedUpdates is nil the previous setting is kept, so nothing is dropped.
+// When info has no allowed updates the bot receives the default set: every type except the opt-in ones
+func (p *SetWebhookParams) DroppedUpdates(info *WebhookInfo) []string {
+	if p.AllowedUpdates == nil || info == nil {
+		return nil
+	}
+	current := info.AllowedUpdates
+	if len(current) == 0 {
+		current = defaultUpdateTypes()
+	}
+	next := make(map[string]bool, len(p.AllowedUpdates))
+	for _, t := range p.AllowedUpdates {
+		next[t] = true
+	}
+
+	var dropped []string
+	for _, t := range current {
+		if len(p.AllowedUpdates) == 0 {
+			// An empty list means everything except the opt-in types
+			if optInUpdateTypes[t] {
+				dropped = append(dropped, t)
+			}
+		} else if !next[t] {
+			dropped = append(dropped, t)
+		}
+	}
+	return dropped
+}
+
+// The update types received with an empty allowed_updates. Only the types this library models are
+// listed: a type it doesn't know can't be handled anyway
+func defaultUpdateTypes() []string {
+	var types []string
+	for _, t := range updateTypes {
+		if !optInUpdateTypes[t] {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// PreserveAllowedUpdates adds to p.AllowedUpdates the update types that DroppedUpdates reports,
+// so the new setWebhook call can widen the set of received updates but never narrow it.
+// Pass the result of getWebhookInfo, fetched right before setWebhook
+func (p *SetWebhookParams) PreserveAllowedUpdates(info *WebhookInfo) {
+	dropped := p.DroppedUpdates(info)
+	if len(dropped) == 0 {
+		return
+	}
+	if len(p.AllowedUpdates) == 0 {
+		// "Everything" can't be combined with the opt-in types without listing all of them,
+		// so keep the previous setting as it is
+		p.AllowedUpdates = nil
+		return
+	}
+	p.AllowedUpdates = append(p.AllowedUpdates, dropped...)
+}
+
+// The networks Telegram sends webhook requests from, as documented. It is a variable so that
+// it can be updated without waiting for a new release, or extended with the address of a proxy
+var TelegramIPRanges = []netip.Prefix{
+	netip.MustParsePrefix("149.154.160.0/20"),
+	netip.MustParsePrefix("91.108.4.0/22"),
+}
+
+// IsTelegramIP reports whether ip belongs to TelegramIPRanges. IPv4 addresses mapped to IPv6 count as IPv4
+func IsTelegramIP(ip net.IP) bool {
+	addr, ok := netip.AddrFromSlice(ip)
+	return ok && inRanges(addr.Unmap(), TelegramIPRanges)
+}
+
+func inRanges(addr netip.Addr, ranges []netip.Prefix) bool {
+	for _, r := range ranges {
+		if r.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IPFilter is a webhook middleware that rejects, with 403 Forbidden, the requests that don't come
+// from Telegram's networks. It is a second line of defence: VerifyWebhookSecret is the real check
+type IPFilter struct {
+	// The allowed networks. If nil, TelegramIPRanges
+	Ranges []netip.Prefix
+
+	// If set, the header holding the address of the client, e.g. "X-Real-IP", for servers behind
+	// a reverse proxy, where the remote address is the one of the proxy. Only the last address of
+	// the header counts, the one added by the proxy: the others come from the client and can't be trusted.
+	// Don't set it if the server is reachable directly, or anyone could send the header
+	ClientIPHeader string
+}
+
+// Allows reports whether the request comes from one of the allowed networks
+func (f *IPFilter) Allows(r *http.Request) bool {
+	ranges := f.Ranges
+	if ranges == nil {
+		ranges = TelegramIPRanges
+	}
+
+	var host string
+	if f.ClientIPHeader != "" {
+		values := r.Header.Values(f.ClientIPHeader)
+		if len(values) == 0 {
+			return false
+		}
+		last := values[len(values)-1]
+		host = strings.TrimSpace(last[strings.LastIndex(last, ",")+1:])
+	} else {
+		var err error
+		if host, _, err = net.SplitHostPort(r.RemoteAddr); err != nil {
+			host = r.RemoteAddr
+		}
+	}
+	addr, err := netip.ParseAddr(host)
+	return err == nil && inRanges(addr.Unmap(), ranges)
+}
+
+// Wrap returns a handler that passes to next only the requests Allows
+func (f *IPFilter) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !f.Allows(r) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,397 @@
+/* webhook_test.go : tests for the webhook helpers
+ *
+ * Copyright (c) 2025 Paolo Giordano
+ * Licensed under the MIT License. See the LICENSE file for more details.
+ */
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSetWebhookValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  SetWebhookParams
+		wantErr bool
+	}{
+		{name: "remove webhook", params: SetWebhookParams{}},
+		{name: "default port", params: SetWebhookParams{URL: "https://example.com/hook"}},
+		{name: "port 443", params: SetWebhookParams{URL: "https://example.com:443/hook"}},
+		{name: "port 80", params: SetWebhookParams{URL: "https://example.com:80/hook"}},
+		{name: "port 88", params: SetWebhookParams{URL: "https://example.com:88/hook"}},
+		{name: "port 8443, self-signed", params: SetWebhookParams{URL: "https://203.0.113.7:8443/hook"}},
+		{name: "port 8080, only a warning", params: SetWebhookParams{URL: "https://example.com:8080/hook"}},
+		{name: "plain http", params: SetWebhookParams{URL: "http://example.com/hook"}, wantErr: true},
+		{name: "max connections 100", params: SetWebhookParams{URL: "https://example.com/hook", MaxConnections: 100}},
+		{name: "max connections 101", params: SetWebhookParams{URL: "https://example.com/hook", MaxConnections: 101}, wantErr: true},
+		{name: "secret token", params: SetWebhookParams{URL: "https://example.com/hook", SecretToken: "abc_DEF-123"}},
+		{name: "secret token with a space", params: SetWebhookParams{URL: "https://example.com/hook", SecretToken: "abc def"}, wantErr: true},
+		{name: "secret token too long", params: SetWebhookParams{URL: "https://example.com/hook", SecretToken: strings.Repeat("a", 257)}, wantErr: true},
+		{name: "certificate", params: SetWebhookParams{URL: "https://example.com/hook", Certificate: &InputFile{Name: "cert.pem", Reader: strings.NewReader("PEM")}}},
+		{name: "empty certificate", params: SetWebhookParams{URL: "https://example.com/hook", Certificate: &InputFile{Name: "cert.pem"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetWebhookWarnings(t *testing.T) {
+	tests := []struct {
+		url  string
+		want int
+	}{
+		{"https://example.com/hook", 0},
+		{"https://203.0.113.7:8443/hook", 0},
+		{"https://example.com:88/hook", 0},
+		{"https://example.com:8080/hook", 1},
+		{"https://example.com:8444/hook", 1},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		p := SetWebhookParams{URL: tt.url}
+		if got := p.Warnings(); len(got) != tt.want {
+			t.Errorf("Warnings() of %q = %q, want %d warnings", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestSetWebhookCertificate(t *testing.T) {
+	params := SetWebhookParams{
+		URL:            "https://203.0.113.7:8443/hook",
+		MaxConnections: 10,
+		AllowedUpdates: []string{"message", "callback_query"},
+		SecretToken:    "abc_DEF-123",
+		Certificate:    &InputFile{Name: "cert.pem", Reader: strings.NewReader("-----BEGIN CERTIFICATE-----")},
+	}
+
+	var form *multipart.Form
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			return nil, err
+		}
+		form = req.MultipartForm
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"ok":true,"result":true}`)),
+			Request:    req,
+		}, nil
+	})}
+	if err := SetWebhook(context.Background(), client, "token", params); err != nil {
+		t.Fatal(err)
+	}
+
+	wantFields := map[string]string{
+		"url":             params.URL,
+		"max_connections": "10",
+		"allowed_updates": `["message","callback_query"]`,
+		"secret_token":    params.SecretToken,
+	}
+	for name, want := range wantFields {
+		if got := form.Value[name]; len(got) != 1 || got[0] != want {
+			t.Errorf("field %s = %q, want %q", name, got, want)
+		}
+	}
+	if _, ok := form.Value["certificate"]; ok {
+		t.Error("certificate sent as a plain field")
+	}
+
+	files := form.File["certificate"]
+	if len(files) != 1 || files[0].Filename != "cert.pem" {
+		t.Fatalf("certificate files = %v", files)
+	}
+	f, err := files[0].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	content, _ := io.ReadAll(f)
+	if string(content) != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("certificate content = %q", content)
+	}
+}
+
+func TestSetWebhookWithoutCertificate(t *testing.T) {
+	var got SetWebhookParams
+	client := fakeClient(func(method string, params []byte) string {
+		if method != "setWebhook" {
+			t.Errorf("called %s", method)
+		}
+		if err := json.Unmarshal(params, &got); err != nil {
+			t.Errorf("parameters aren't JSON: %v", err)
+		}
+		return `{"ok":true,"result":true}`
+	})
+	if err := SetWebhook(context.Background(), client, "token", SetWebhookParams{URL: "https://example.com/hook"}); err != nil {
+		t.Fatal(err)
+	}
+	if got.URL != "https://example.com/hook" {
+		t.Errorf("sent %+v", got)
+	}
+}
+
+func TestWebhookInfoCustomCertificate(t *testing.T) {
+	for _, want := range []bool{true, false} {
+		data := `{"url":"https://203.0.113.7:8443/hook","has_custom_certificate":` + strconv.FormatBool(want) + `,"pending_update_count":3}`
+		var info WebhookInfo
+		if err := json.Unmarshal([]byte(data), &info); err != nil {
+			t.Fatal(err)
+		}
+		if info.HasCustomCertificate != want || info.PendingUpdateCount != 3 {
+			t.Errorf("decoded %+v from %s", info, data)
+		}
+
+		// has_custom_certificate has no omitempty: false must be sent back too
+		out, err := json.Marshal(info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var again WebhookInfo
+		if err := json.Unmarshal(out, &again); err != nil {
+			t.Fatal(err)
+		}
+		if again.HasCustomCertificate != want || !strings.Contains(string(out), `"has_custom_certificate"`) {
+			t.Errorf("round trip of %+v gave %s", info, out)
+		}
+	}
+}
+
+func TestDroppedUpdates(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		next    []string
+		want    []string
+	}{
+		{name: "keep previous setting", current: []string{UpdateTypeMessage}, next: nil, want: nil},
+		{name: "same list", current: []string{UpdateTypeMessage, UpdateTypeCallbackQuery}, next: []string{UpdateTypeCallbackQuery, UpdateTypeMessage}, want: nil},
+		{name: "narrowed list", current: []string{UpdateTypeMessage, UpdateTypeCallbackQuery}, next: []string{UpdateTypeMessage}, want: []string{UpdateTypeCallbackQuery}},
+		{name: "list to default drops opt-in", current: []string{UpdateTypeMessage, UpdateTypeChatMember}, next: []string{}, want: []string{UpdateTypeChatMember}},
+		{name: "default to default", current: nil, next: []string{}, want: nil},
+		{
+			name:    "default to list",
+			current: nil,
+			next:    []string{UpdateTypeMessage, UpdateTypeCallbackQuery},
+			want: []string{
+				UpdateTypeEditedMessage, UpdateTypeChannelPost, UpdateTypeEditedChannelPost, UpdateTypeInlineQuery,
+				UpdateTypePurchasedPaidMedia, UpdateTypeChatBoost, UpdateTypeRemovedChatBoost, UpdateTypeShippingQuery,
+				UpdateTypeMyChatMember, UpdateTypeBusinessMessage, UpdateTypeEditedBusinessMessage,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := SetWebhookParams{URL: "https://example.com/hook", AllowedUpdates: tt.next}
+			got := p.DroppedUpdates(&WebhookInfo{AllowedUpdates: tt.current})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DroppedUpdates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreserveAllowedUpdates(t *testing.T) {
+	p := SetWebhookParams{URL: "https://example.com/hook", AllowedUpdates: []string{UpdateTypeMessage}}
+	p.PreserveAllowedUpdates(&WebhookInfo{})
+	if !slices.Contains(p.AllowedUpdates, UpdateTypeCallbackQuery) || slices.Contains(p.AllowedUpdates, UpdateTypeChatMember) {
+		t.Errorf("AllowedUpdates = %v, want the default set", p.AllowedUpdates)
+	}
+	if p.DroppedUpdates(&WebhookInfo{}) != nil {
+		t.Errorf("still drops %v", p.DroppedUpdates(&WebhookInfo{}))
+	}
+}
+
+func TestVerifyWebhookSecret(t *testing.T) {
+	secret := GenerateWebhookSecret()
+	tests := []struct {
+		name   string
+		header string
+		secret string
+		want   bool
+	}{
+		{name: "right secret", header: secret, secret: secret, want: true},
+		{name: "wrong secret", header: "wrong", secret: secret, want: false},
+		{name: "no header", header: "", secret: secret, want: false},
+		{name: "empty secret, no header", header: "", secret: "", want: false},
+		{name: "empty secret, some header", header: "anything", secret: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/hook", nil)
+			if tt.header != "" {
+				r.Header.Set(WebhookSecretHeader, tt.header)
+			}
+			if got := VerifyWebhookSecret(r, tt.secret); got != tt.want {
+				t.Errorf("VerifyWebhookSecret() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateWebhookSecret(t *testing.T) {
+	secret := GenerateWebhookSecret()
+	if err := validateSecretToken(secret); err != nil {
+		t.Errorf("generated secret %q is invalid: %v", secret, err)
+	}
+	if GenerateWebhookSecret() == secret {
+		t.Error("two generated secrets are equal")
+	}
+}
+
+func TestIsTelegramIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "149.154.159.255", want: false},
+		{ip: "149.154.160.0", want: true},
+		{ip: "149.154.167.99", want: true},
+		{ip: "149.154.175.255", want: true},
+		{ip: "149.154.176.0", want: false},
+		{ip: "91.108.3.255", want: false},
+		{ip: "91.108.4.0", want: true},
+		{ip: "91.108.7.255", want: true},
+		{ip: "91.108.8.0", want: false},
+		{ip: "::ffff:149.154.160.1", want: true},
+		{ip: "::ffff:91.108.8.0", want: false},
+		{ip: "2001:db8::1", want: false},
+		{ip: "127.0.0.1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := IsTelegramIP(net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("IsTelegramIP(%s) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+	if IsTelegramIP(nil) {
+		t.Error("IsTelegramIP(nil) = true, want false")
+	}
+}
+
+func TestIPFilterAllows(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     IPFilter
+		remoteAddr string
+		header     []string
+		want       bool
+	}{
+		{name: "telegram address", remoteAddr: "149.154.167.99:41000", want: true},
+		{name: "other address", remoteAddr: "203.0.113.7:41000", want: false},
+		{name: "ipv6 remote address", remoteAddr: "[2001:db8::1]:41000", want: false},
+		{name: "remote address without port", remoteAddr: "91.108.4.1", want: true},
+		{
+			name:       "custom ranges",
+			filter:     IPFilter{Ranges: []netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")}},
+			remoteAddr: "203.0.113.7:41000",
+			want:       true,
+		},
+		{
+			name:       "custom ranges replace telegram's",
+			filter:     IPFilter{Ranges: []netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")}},
+			remoteAddr: "149.154.167.99:41000",
+			want:       false,
+		},
+		{
+			name:       "header from the proxy",
+			filter:     IPFilter{ClientIPHeader: "X-Forwarded-For"},
+			remoteAddr: "10.0.0.1:41000",
+			header:     []string{"149.154.167.99"},
+			want:       true,
+		},
+		{
+			name:       "only the last address of the header counts",
+			filter:     IPFilter{ClientIPHeader: "X-Forwarded-For"},
+			remoteAddr: "10.0.0.1:41000",
+			header:     []string{"149.154.167.99, 203.0.113.7"},
+			want:       false,
+		},
+		{
+			name:       "spoofed first address",
+			filter:     IPFilter{ClientIPHeader: "X-Forwarded-For"},
+			remoteAddr: "10.0.0.1:41000",
+			header:     []string{"203.0.113.7, 149.154.167.99"},
+			want:       true,
+		},
+		{
+			name:       "last of repeated headers",
+			filter:     IPFilter{ClientIPHeader: "X-Forwarded-For"},
+			remoteAddr: "10.0.0.1:41000",
+			header:     []string{"149.154.167.99", "203.0.113.7"},
+			want:       false,
+		},
+		{
+			name:       "missing header",
+			filter:     IPFilter{ClientIPHeader: "X-Real-IP"},
+			remoteAddr: "149.154.167.99:41000",
+			want:       false,
+		},
+		{
+			name:       "garbage header",
+			filter:     IPFilter{ClientIPHeader: "X-Real-IP"},
+			remoteAddr: "10.0.0.1:41000",
+			header:     []string{"not an address"},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/hook", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, v := range tt.header {
+				r.Header.Add(tt.filter.ClientIPHeader, v)
+			}
+			if got := tt.filter.Allows(r); got != tt.want {
+				t.Errorf("Allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPFilterWrap(t *testing.T) {
+	var called bool
+	handler := (&IPFilter{}).Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	tests := []struct {
+		remoteAddr string
+		wantCode   int
+		wantCalled bool
+	}{
+		{remoteAddr: "149.154.167.99:41000", wantCode: http.StatusOK, wantCalled: true},
+		{remoteAddr: "203.0.113.7:41000", wantCode: http.StatusForbidden, wantCalled: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.remoteAddr, func(t *testing.T) {
+			called = false
+			r := httptest.NewRequest(http.MethodPost, "/hook", nil)
+			r.RemoteAddr = tt.remoteAddr
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("next called = %v, want %v", called, tt.wantCalled)
+			}
+		})
+	}
+}